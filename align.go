@@ -0,0 +1,142 @@
+package grpt
+
+type Align struct {
+	Size      Size
+	Alignment Alignment
+	Baseline  float64
+	Child     Element
+
+	wasMeasuredAtLeastOnce bool
+	originalSize           Size
+}
+
+func NewAlign(alignment Alignment, child Element) *Align {
+	return &Align{Alignment: alignment, Child: child}
+}
+
+func (a Align) GetSize() Size {
+	return a.Size
+}
+
+func (a *Align) Measure(boundries Size, renderer *DocumentRenderer) {
+	if a.wasMeasuredAtLeastOnce {
+		a.Size = a.originalSize
+	} else {
+		a.originalSize = a.Size
+	}
+	a.wasMeasuredAtLeastOnce = true
+
+	a.Size = a.Size.Merge(boundries)
+	if a.Child == nil {
+		return
+	}
+
+	measureLoosely(a.Child, a.Size, renderer)
+	a.Size = a.Size.Merge(a.Child.GetSize())
+}
+
+func (a *Align) Render(renderer *DocumentRenderer) error {
+	defer renderer.SetOffset(renderer.GetCurrentOffset())
+	if a.Child == nil {
+		return nil
+	}
+
+	offset := a.Alignment.Offset(a.Size, a.Child.GetSize())
+	if a.Alignment&BaselineAlignment != 0 {
+		offset.Y = a.Baseline - a.Child.GetSize().Height
+		if child, ok := a.Child.(BaselineElement); ok {
+			offset.Y = a.Baseline - child.Baseline(renderer)
+		}
+	}
+
+	renderer.AddOffset(offset)
+	return a.Child.Render(renderer)
+}
+
+type Center struct {
+	Size  Size
+	Child Element
+
+	align Align
+}
+
+func NewCenter(child Element) *Center {
+	return &Center{Child: child}
+}
+
+func (c Center) GetSize() Size {
+	return c.align.GetSize()
+}
+
+func (c *Center) Measure(boundries Size, renderer *DocumentRenderer) {
+	c.align.Size = c.Size
+	c.align.Alignment = CenterAlignment
+	c.align.Child = c.Child
+	c.align.Measure(boundries, renderer)
+}
+
+func (c *Center) Render(renderer *DocumentRenderer) error {
+	return c.align.Render(renderer)
+}
+
+type Padding struct {
+	Padding EdgeInsets
+	Child   Element
+
+	size Size
+}
+
+func NewPadding(padding EdgeInsets, child Element) *Padding {
+	return &Padding{Padding: padding, Child: child}
+}
+
+func (p Padding) GetSize() Size {
+	return p.size
+}
+
+func (p *Padding) Measure(boundries Size, renderer *DocumentRenderer) {
+	if p.Child == nil {
+		p.size = NewSize(0, 0).WithoutPadding(p.Padding)
+		return
+	}
+
+	inner := boundries
+	if inner.Width != 0 && inner.Width != MaxSize {
+		inner.Width = max(inner.Width-p.Padding.Left-p.Padding.Right, 0)
+	}
+
+	if inner.Height != 0 && inner.Height != MaxSize {
+		inner.Height = max(inner.Height-p.Padding.Top-p.Padding.Bottom, 0)
+	}
+
+	measureLoosely(p.Child, inner, renderer)
+	p.size = p.Child.GetSize().WithoutPadding(p.Padding)
+}
+
+func (p *Padding) Render(renderer *DocumentRenderer) error {
+	defer renderer.SetOffset(renderer.GetCurrentOffset())
+	if p.Child == nil {
+		return nil
+	}
+
+	renderer.AddXY(p.Padding.Left, p.Padding.Top)
+	return p.Child.Render(renderer)
+}
+
+func measureLoosely(
+	element Element,
+	boundries Size,
+	renderer *DocumentRenderer,
+) {
+	switch element.(type) {
+	case *Text, *Image:
+	default:
+		element.Measure(boundries, renderer)
+		return
+	}
+
+	element.Measure(NewSize(0, 0), renderer)
+	if boundries.Width > 0 && element.GetSize().Width > boundries.Width {
+		element.Measure(NewWidth(boundries.Width), renderer)
+	}
+}
@@ -0,0 +1,47 @@
+package grpt
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestAlignUnsizedContainer(t *testing.T) {
+	tests := []struct {
+		name    string
+		element Element
+		wantErr error
+	}{
+		{
+			name:    "container sized by its child",
+			element: NewCenter(&Container{Child: &Text{Value: "centered"}}),
+		},
+		{
+			name:    "container without child",
+			element: NewCenter(&Container{}),
+			wantErr: ErrInvalidSize,
+		},
+		{
+			name: "container with a flat child",
+			element: NewAlign(
+				TopAlignment|LeftAlignment,
+				&Container{Child: NewHorizontalSpace(40)},
+			),
+			wantErr: ErrInvalidSize,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			renderer := newTestRenderer(t)
+			test.element.Measure(NewSize(0, 0), renderer)
+
+			err := test.element.Render(renderer)
+			if test.wantErr == nil && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if test.wantErr != nil && !errors.Is(err, test.wantErr) {
+				t.Fatalf("err = %v, want %v", err, test.wantErr)
+			}
+		})
+	}
+}
@@ -9,27 +9,46 @@ const (
 	VerticalCenterAlignment   Alignment = 32
 	TopAlignment              Alignment = 4
 	BottomAlignment           Alignment = 1
+	BaselineAlignment         Alignment = 64
+	JustifiedAlignment        Alignment = 128
 
 	CenterAlignment Alignment = HorizontalCenterAlignment | VerticalCenterAlignment
+
+	horizontalAlignments = LeftAlignment | RightAlignment |
+		HorizontalCenterAlignment | JustifiedAlignment
+	verticalAlignments = TopAlignment | BottomAlignment |
+		VerticalCenterAlignment | BaselineAlignment
 )
 
 func (a Alignment) IsValid() bool {
-	switch a {
-	case LeftAlignment, RightAlignment,
-		HorizontalCenterAlignment, VerticalCenterAlignment, CenterAlignment,
-		TopAlignment, BottomAlignment:
-		if a&LeftAlignment != 0 &&
-			(a&RightAlignment != 0 || a&HorizontalCenterAlignment != 0) {
-			return false
-		}
+	if a <= 0 || a&^(horizontalAlignments|verticalAlignments) != 0 {
+		return false
+	}
+
+	horizontal := a & horizontalAlignments
+	vertical := a & verticalAlignments
+	return horizontal&(horizontal-1) == 0 && vertical&(vertical-1) == 0
+}
 
-		if a&TopAlignment != 0 &&
-			(a&BottomAlignment != 0 || a&VerticalCenterAlignment != 0) {
-			return false
+func (a Alignment) Offset(space Size, child Size) Offset {
+	emptySpace := space.Difference(child)
+	offset := Offset{}
+
+	if emptySpace.Width > 0 {
+		if a&RightAlignment != 0 {
+			offset.X = emptySpace.Width
+		} else if a&HorizontalCenterAlignment != 0 {
+			offset.X = emptySpace.Width / 2
 		}
+	}
 
-		return true
-	default:
-		return false
+	if emptySpace.Height > 0 {
+		if a&BottomAlignment != 0 {
+			offset.Y = emptySpace.Height
+		} else if a&VerticalCenterAlignment != 0 {
+			offset.Y = emptySpace.Height / 2
+		}
 	}
+
+	return offset
 }
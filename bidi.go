@@ -0,0 +1,246 @@
+package grpt
+
+import (
+	"slices"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/unicode/bidi"
+)
+
+type TextDirection int
+
+const (
+	TextDirectionAuto TextDirection = iota
+	TextDirectionLTR
+	TextDirectionRTL
+)
+
+const leftToRightMark rune = 0x200E
+
+var bidiMirrors = map[rune]rune{
+	'(': ')', ')': '(',
+	'[': ']', ']': '[',
+	'{': '}', '}': '{',
+	'<': '>', '>': '<',
+	'«': '»', '»': '«',
+	'‹': '›', '›': '‹',
+}
+
+func (d TextDirection) Resolve(text string) TextDirection {
+	if d != TextDirectionAuto {
+		return d
+	}
+
+	for _, char := range text {
+		switch bidiClass(char) {
+		case bidi.L:
+			return TextDirectionLTR
+		case bidi.R, bidi.AL:
+			return TextDirectionRTL
+		}
+	}
+
+	return TextDirectionLTR
+}
+
+func (d TextDirection) IsRTL() bool {
+	return d == TextDirectionRTL
+}
+
+func ContainsRTL(text string) bool {
+	return strings.ContainsFunc(text, func(char rune) bool {
+		switch bidiClass(char) {
+		case bidi.R, bidi.AL, bidi.AN:
+			return true
+		default:
+			return false
+		}
+	})
+}
+
+func ReorderBidiText(text string, direction TextDirection) string {
+	direction = direction.Resolve(text)
+	if len(text) == 0 || !direction.IsRTL() && !ContainsRTL(text) {
+		return text
+	}
+
+	baseLevel := 0
+	if direction.IsRTL() {
+		baseLevel = 1
+	}
+
+	levels, ok := resolveBidiLevels(text, baseLevel)
+	if !ok {
+		return text
+	}
+
+	runes := []rune(text)
+	for index := len(runes) - 1; index >= 0; index-- {
+		if !unicode.IsSpace(runes[index]) {
+			break
+		}
+		levels[index] = baseLevel
+	}
+
+	clusters := splitGraphemes(text)
+	clusterLevels := make([]int, len(clusters))
+	position := 0
+	for index, cluster := range clusters {
+		clusterLevels[index] = levels[position]
+		position += len([]rune(cluster))
+
+		if clusterLevels[index]%2 == 1 {
+			clusters[index] = mirrorBidiCluster(cluster)
+		}
+	}
+
+	highest := slices.Max(clusterLevels)
+	lowestOdd := highest + 1
+	for _, level := range clusterLevels {
+		if level%2 == 1 && level < lowestOdd {
+			lowestOdd = level
+		}
+	}
+
+	for level := highest; level >= lowestOdd; level-- {
+		for start := 0; start < len(clusters); {
+			if clusterLevels[start] < level {
+				start++
+				continue
+			}
+
+			end := start
+			for end < len(clusters) && clusterLevels[end] >= level {
+				end++
+			}
+
+			slices.Reverse(clusters[start:end])
+			slices.Reverse(clusterLevels[start:end])
+			start = end
+		}
+	}
+
+	return strings.Join(clusters, "")
+}
+
+func resolveBidiLevels(text string, baseLevel int) ([]int, bool) {
+	var paragraph bidi.Paragraph
+	var err error
+	if baseLevel == 0 {
+		text = string(leftToRightMark) + text
+		_, err = paragraph.SetString(text)
+	} else {
+		_, err = paragraph.SetString(text, bidi.DefaultDirection(bidi.RightToLeft))
+	}
+	if err != nil {
+		return nil, false
+	}
+
+	ordering, err := paragraph.Order()
+	if err != nil {
+		return nil, false
+	}
+
+	runes := []rune(text)
+	levels := make([]int, len(runes))
+	isOdd := func(run int) bool {
+		if run < 0 || run >= ordering.NumRuns() {
+			return false
+		}
+		current := ordering.Run(run)
+		return current.Direction() == bidi.RightToLeft
+	}
+
+	covered := 0
+	for index := range ordering.NumRuns() {
+		run := ordering.Run(index)
+		start, end := run.Pos()
+		end = min(end+1, len(runes))
+		covered = max(covered, end)
+
+		if run.Direction() == bidi.RightToLeft {
+			fillBidiLevel(levels[start:end], baseLevel|1)
+			continue
+		}
+
+		if baseLevel == 1 {
+			fillBidiLevel(levels[start:end], 2)
+			continue
+		}
+
+		if isOdd(index - 1) {
+			prefix := numericPrefix(runes[start:end], bidi.EN, bidi.AN)
+			fillBidiLevel(levels[start:start+prefix], 2)
+		}
+
+		if isOdd(index + 1) {
+			suffix := numericSuffix(runes[start:end], bidi.AN)
+			fillBidiLevel(levels[end-suffix:end], 2)
+		}
+	}
+
+	if covered < len(runes) {
+		return nil, false
+	}
+
+	if baseLevel == 0 {
+		levels = levels[1:]
+	}
+	return levels, true
+}
+
+func numericPrefix(runes []rune, digits ...bidi.Class) int {
+	length := 0
+	for index, char := range runes {
+		class := bidiClass(char)
+		if slices.Contains(digits, class) {
+			length = index + 1
+			continue
+		}
+
+		if !isNumberSeparator(class) {
+			break
+		}
+	}
+	return length
+}
+
+func numericSuffix(runes []rune, digits ...bidi.Class) int {
+	length := 0
+	for index := len(runes) - 1; index >= 0; index-- {
+		class := bidiClass(runes[index])
+		if slices.Contains(digits, class) {
+			length = len(runes) - index
+			continue
+		}
+
+		if !isNumberSeparator(class) {
+			break
+		}
+	}
+	return length
+}
+
+func isNumberSeparator(class bidi.Class) bool {
+	return class == bidi.ES || class == bidi.CS || class == bidi.ET
+}
+
+func fillBidiLevel(levels []int, level int) {
+	for index := range levels {
+		levels[index] = level
+	}
+}
+
+func bidiClass(char rune) bidi.Class {
+	properties, _ := bidi.LookupRune(char)
+	return properties.Class()
+}
+
+func mirrorBidiCluster(cluster string) string {
+	runes := []rune(cluster)
+	if mirror, ok := bidiMirrors[runes[0]]; ok {
+		runes[0] = mirror
+	}
+	return string(runes)
+}
@@ -0,0 +1,71 @@
+package grpt
+
+import "testing"
+
+func TestReorderBidiText(t *testing.T) {
+	tests := []struct {
+		name      string
+		text      string
+		direction TextDirection
+		want      string
+	}{
+		{"latin only", "abc def", TextDirectionAuto, "abc def"},
+		{"hebrew only", "שלום", TextDirectionAuto, "םולש"},
+		{"hebrew inside latin", "abc שלום def", TextDirectionLTR, "abc םולש def"},
+		{"latin inside hebrew", "שלום abc", TextDirectionAuto, "abc םולש"},
+		{"forced LTR paragraph", "שלום abc", TextDirectionLTR, "םולש abc"},
+		{"number between hebrew words", "שלום 123 עולם", TextDirectionLTR, "םלוע 123 םולש"},
+		{"number after hebrew word", "abc שלום 123", TextDirectionLTR, "abc 123 םולש"},
+		{"arabic digits before hebrew", "abc ١٢٣ שלום", TextDirectionLTR, "abc םולש ١٢٣"},
+		{"decimal number in RTL", "מחיר 12.50", TextDirectionRTL, "12.50 ריחמ"},
+		{"mirrored brackets", "(שלום)", TextDirectionRTL, "(םולש)"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := ReorderBidiText(test.text, test.direction); got != test.want {
+				t.Fatalf("ReorderBidiText(%q) = %q, want %q", test.text, got, test.want)
+			}
+		})
+	}
+}
+
+func TestTextDirectionPerParagraph(t *testing.T) {
+	tests := []struct {
+		name      string
+		text      string
+		direction TextDirection
+		want      []TextDirection
+	}{
+		{
+			name: "auto",
+			text: "שלום עולם\nhello world\n123 שלום",
+			want: []TextDirection{TextDirectionRTL, TextDirectionLTR, TextDirectionRTL},
+		},
+		{
+			name:      "explicit",
+			text:      "שלום עולם\nhello world",
+			direction: TextDirectionLTR,
+			want:      []TextDirection{TextDirectionLTR, TextDirectionLTR},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			style := &TextStyle{Multiline: true, Direction: test.direction}
+			lines, err := newTestRenderer(t).SplitTextLines(test.text, 500, style)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if len(lines) != len(test.want) {
+				t.Fatalf("got %d lines, want %d", len(lines), len(test.want))
+			}
+			for index, line := range lines {
+				if line.Direction != test.want[index] {
+					t.Errorf("line %d direction = %v, want %v", index, line.Direction, test.want[index])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,118 @@
+package grpt
+
+import "slices"
+
+type blockFlow struct {
+	blocks   Elements
+	spacings []float64
+}
+
+func (f *blockFlow) add(block Element, spacing float64) {
+	if len(f.blocks) == 0 {
+		spacing = 0
+	}
+
+	f.blocks = append(f.blocks, block)
+	f.spacings = append(f.spacings, spacing)
+}
+
+func (f *blockFlow) measure(width float64, renderer *DocumentRenderer) float64 {
+	var height float64
+	for index, block := range f.blocks {
+		block.Measure(NewWidth(width), renderer)
+		height += f.spacings[index] + block.GetSize().Height
+	}
+	return height
+}
+
+func (f *blockFlow) render(renderer *DocumentRenderer) error {
+	defer renderer.SetOffset(renderer.GetCurrentOffset())
+
+	for index, block := range f.blocks {
+		renderer.AddY(f.spacings[index])
+		if err := block.Render(renderer); err != nil {
+			return err
+		}
+		renderer.AddY(block.GetSize().Height)
+	}
+
+	return nil
+}
+
+func (f *blockFlow) split(
+	height float64,
+	renderer *DocumentRenderer,
+) (*blockFlow, *blockFlow, bool) {
+	var used float64
+	for index, block := range f.blocks {
+		used += f.spacings[index]
+		if used+block.GetSize().Height <= height {
+			used += block.GetSize().Height
+			continue
+		}
+
+		head := &blockFlow{
+			blocks:   slices.Clone(f.blocks[:index]),
+			spacings: slices.Clone(f.spacings[:index]),
+		}
+		tail := &blockFlow{
+			blocks:   slices.Clone(f.blocks[index+1:]),
+			spacings: slices.Clone(f.spacings[index+1:]),
+		}
+
+		blockHead, blockTail := splitElement(block, height-used, renderer)
+		if blockHead != nil {
+			head.blocks = append(head.blocks, blockHead)
+			head.spacings = append(head.spacings, f.spacings[index])
+		}
+
+		if blockTail != nil {
+			tail.blocks = slices.Insert(tail.blocks, 0, blockTail)
+			tail.spacings = slices.Insert(tail.spacings, 0, 0)
+		} else if blockHead == nil {
+			tail.blocks = slices.Insert(tail.blocks, 0, block)
+			tail.spacings = slices.Insert(tail.spacings, 0, 0)
+		}
+
+		if len(head.blocks) == 0 {
+			return nil, nil, false
+		}
+
+		if len(tail.blocks) == 0 {
+			return f, nil, true
+		}
+
+		return head, tail, true
+	}
+
+	return f, nil, true
+}
+
+func newTableRow(
+	width float64,
+	cells []*RichText,
+	renderer *DocumentRenderer,
+) *Row {
+	if len(cells) == 0 {
+		return &Row{Size: NewSize(width, 0)}
+	}
+
+	cellWidth := width / float64(len(cells))
+
+	var height float64
+	for _, cell := range cells {
+		cell.Measure(NewWidth(cellWidth), renderer)
+		height = max(height, cell.GetSize().Height)
+	}
+
+	row := &Row{Size: NewSize(width, height)}
+	for _, cell := range cells {
+		row.Children = append(row.Children, &RichText{
+			Size:  NewSize(cellWidth, height),
+			Style: cell.Style,
+			Spans: cell.Spans,
+		})
+	}
+
+	return row
+}
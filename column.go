@@ -1,5 +1,7 @@
 package grpt
 
+import "slices"
+
 type Column struct {
 	Name             string
 	Size             Size
@@ -13,6 +15,7 @@ type Column struct {
 	currentChildIndex      int
 	wasMeasuredAtLeastOnce bool
 	originalSize           Size
+	boundries              Size
 }
 
 func (c Column) GetSize() Size {
@@ -27,6 +30,7 @@ func (c *Column) Measure(boundries Size, renderer *DocumentRenderer) {
 		c.originalPage = renderer.GetCurrentPage()
 	}
 	c.wasMeasuredAtLeastOnce = true
+	c.boundries = boundries
 
 	if c.Size.Width == MaxSize {
 		c.Size.Width = boundries.Width
@@ -49,16 +53,32 @@ func (c *Column) Measure(boundries Size, renderer *DocumentRenderer) {
 		c.DefaultChildSize.Width = c.Size.Width
 	}
 
-	if c.DefaultChildSize.Height == 0 || c.DefaultChildSize.Height == MaxSize {
+	flowing := c.OverflowMode == OverflowModeContinueOnNextPage
+	if flowing {
+		for _, child := range c.Children {
+			if _, ok := child.(SplittableElement); ok {
+				child.Measure(NewWidth(c.DefaultChildSize.Width), renderer)
+			}
+		}
+	}
+
+	unbounded := c.Size.Height == 0 && boundries.Height == 0
+	if !unbounded &&
+		(c.DefaultChildSize.Height == 0 || c.DefaultChildSize.Height == MaxSize) {
 		size := CalculateUnsizedElementSize(
 			c.Children,
 			c.Size.Merge(boundries),
 			VerticalAxis,
 		)
-		c.DefaultChildSize.Height = size.Height
+		c.DefaultChildSize.Height = max(size.Height, 0)
 	}
 
-	MeasureAll(c.Children, c.DefaultChildSize.Merge(c.Size), renderer)
+	for _, child := range c.Children {
+		if _, ok := child.(SplittableElement); ok && flowing {
+			continue
+		}
+		child.Measure(c.DefaultChildSize.Merge(c.Size), renderer)
+	}
 
 	if c.Size.Height == 0 {
 		c.Size.Height = TotalHeight(c.Children[c.currentChildIndex:])
@@ -69,7 +89,7 @@ func (c *Column) Measure(boundries Size, renderer *DocumentRenderer) {
 		}
 	}
 
-	if c.Size.Height > boundries.Height {
+	if boundries.Height > 0 && c.Size.Height > boundries.Height {
 		c.Size.Height = boundries.Height
 	}
 }
@@ -96,13 +116,46 @@ func (c *Column) Render(renderer *DocumentRenderer) error {
 	}
 
 	position := renderer.GetCurrentOffset()
-	for index, child := range c.Children {
+	children := slices.Clone(c.Children)
+	for index := 0; index < len(children); index++ {
+		child := children[index]
 		c.currentChildIndex = index
 		if c.OverflowMode == OverflowModeContinueOnNextPage {
-			if !renderer.FitsIn(child.GetSize(), position, c.Size) {
+			if pageBreak, ok := child.(*PageBreak); ok {
+				available := c.availableSpace(position, renderer)
+				if pageBreak.ShouldBreak(available) {
+					renderer.AddPage()
+					position = renderer.GetCurrentOffset()
+				}
+				continue
+			}
+
+			if renderer.GetY() > position.Y &&
+				!c.fitsWithNext(children[index:], position, renderer) {
 				renderer.AddPage()
 				position = renderer.GetCurrentOffset()
 			}
+
+			if !renderer.FitsIn(child.GetSize(), position, c.pageSize()) {
+				head, tail := c.splitChild(child, position, renderer)
+				if tail == nil {
+					renderer.AddPage()
+					position = renderer.GetCurrentOffset()
+					head, tail = c.splitChild(child, position, renderer)
+				}
+
+				if tail != nil {
+					if err := head.Render(renderer); err != nil {
+						return err
+					}
+
+					renderer.AddPage()
+					position = renderer.GetCurrentOffset()
+					children[index] = tail
+					index--
+					continue
+				}
+			}
 		}
 
 		if err := child.Render(renderer); err != nil {
@@ -110,7 +163,7 @@ func (c *Column) Render(renderer *DocumentRenderer) error {
 		}
 
 		renderer.AddOffsetFromAxis(child.GetSize().ToOffset(), VerticalAxis)
-		if index < len(c.Children)-1 {
+		if index < len(children)-1 {
 			if c.Separator != nil {
 				c.Separator.Render(renderer)
 				renderer.AddOffsetFromAxis(
@@ -125,3 +178,132 @@ func (c *Column) Render(renderer *DocumentRenderer) error {
 
 	return nil
 }
+
+func (c *Column) pageSize() Size {
+	if c.boundries.Height > c.Size.Height {
+		return NewSize(c.Size.Width, c.boundries.Height)
+	}
+	return c.Size
+}
+
+func (c *Column) availableSpace(
+	position Offset,
+	renderer *DocumentRenderer,
+) float64 {
+	return position.Y + c.pageSize().Height - renderer.GetY()
+}
+
+func (c *Column) fitsWithNext(
+	children Elements,
+	position Offset,
+	renderer *DocumentRenderer,
+) bool {
+	available := c.availableSpace(position, renderer)
+	for index, child := range children {
+		isLast := index == len(children)-1 || !keepsWithNext(child)
+		if index > 0 && c.Separator != nil {
+			available -= c.Separator.GetSize().Height
+		}
+
+		height := child.GetSize().Height
+		if height <= available {
+			if isLast {
+				return true
+			}
+			available -= height
+			continue
+		}
+
+		if index == 0 {
+			return true
+		}
+
+		head, _ := splitElement(child, available, renderer)
+		return head != nil
+	}
+
+	return true
+}
+
+func (c *Column) splitChild(
+	child Element,
+	position Offset,
+	renderer *DocumentRenderer,
+) (Element, Element) {
+	available := c.availableSpace(position, renderer)
+	return splitElement(child, available, renderer)
+}
+
+func (c *Column) Split(
+	height float64,
+	renderer *DocumentRenderer,
+) (Element, Element, bool) {
+	if c.Size.Height <= height {
+		return c, nil, true
+	}
+
+	var separatorHeight float64
+	if c.Separator != nil {
+		separatorHeight = c.Separator.GetSize().Height
+	}
+
+	var used float64
+	for index, child := range c.Children {
+		if index > 0 {
+			used += separatorHeight
+		}
+
+		childHeight := child.GetSize().Height
+		if used+childHeight <= height {
+			used += childHeight
+			continue
+		}
+
+		head := slices.Clone(c.Children[:index])
+		tail := slices.Clone(c.Children[index+1:])
+
+		childHead, childTail := splitElement(child, height-used, renderer)
+		if childHead != nil {
+			head = append(head, childHead)
+		}
+
+		if childTail != nil {
+			tail = slices.Insert(tail, 0, childTail)
+		} else if childHead == nil {
+			tail = slices.Insert(tail, 0, child)
+		}
+
+		if len(head) == 0 {
+			return nil, nil, false
+		}
+
+		if len(tail) == 0 {
+			return c, nil, true
+		}
+
+		headHeight := TotalHeight(head)
+		headHeight += separatorHeight * float64(len(head)-1)
+
+		headColumn := c.fragment(head, headHeight)
+		headColumn.OverflowMode = OverflowModeTruncate
+
+		tailColumn := c.fragment(tail, 0)
+		tailColumn.Measure(NewWidth(c.Size.Width), renderer)
+
+		return headColumn, tailColumn, true
+	}
+
+	return c, nil, true
+}
+
+func (c *Column) fragment(children Elements, height float64) *Column {
+	return &Column{
+		Name:             c.Name,
+		Size:             NewSize(c.Size.Width, height),
+		Separator:        c.Separator,
+		Justify:          c.Justify,
+		DefaultChildSize: c.DefaultChildSize,
+		OverflowMode:     c.OverflowMode,
+		Children:         children,
+	}
+}
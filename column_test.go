@@ -0,0 +1,61 @@
+package grpt
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestColumnContinueOnNextPage(t *testing.T) {
+	rows := make(Elements, 200)
+	for index := range rows {
+		rows[index] = &Text{Value: "row", Size: NewSize(MaxSize, 20)}
+	}
+
+	paragraph := strings.TrimSpace(strings.Repeat("line of text\n", 200))
+
+	tests := []struct {
+		name     string
+		elements Elements
+		pages    int
+	}{
+		{
+			name:     "tall nested column",
+			elements: Elements{&Column{Children: rows}},
+			pages:    5,
+		},
+		{
+			name: "long multiline text",
+			elements: Elements{
+				&Text{Value: paragraph, Style: TextStyle{Multiline: true}},
+			},
+			pages: 2,
+		},
+		{
+			name: "long multiline text with a sibling",
+			elements: Elements{
+				&Text{Value: "title", Size: NewSize(MaxSize, 30)},
+				&Text{Value: paragraph, Style: TextStyle{Multiline: true}},
+			},
+			pages: 3,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			document := &Document{
+				PageSize: PageSizeA4,
+				Padding:  NewEdgeInsets(20, 20, 20, 20),
+				Body:     DocumentBody{Elements: test.elements},
+			}
+
+			renderer, err := document.build()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if pages := renderer.GetCurrentPage(); pages != test.pages {
+				t.Fatalf("expected %d pages, got %d", test.pages, pages)
+			}
+		})
+	}
+}
@@ -0,0 +1,223 @@
+package grpt
+
+import (
+	"fmt"
+	"slices"
+)
+
+type Columns struct {
+	Size     Size
+	Count    int
+	Gutter   float64
+	Rule     *LineOptions
+	Children Elements
+
+	columns                []Elements
+	wasMeasuredAtLeastOnce bool
+	originalSize           Size
+}
+
+func (c Columns) GetSize() Size {
+	return c.Size
+}
+
+func (c *Columns) Measure(boundries Size, renderer *DocumentRenderer) {
+	if c.wasMeasuredAtLeastOnce {
+		c.Size = c.originalSize
+	} else {
+		c.originalSize = c.Size
+	}
+	c.wasMeasuredAtLeastOnce = true
+	c.columns = nil
+
+	if c.Size.Width == 0 || c.Size.Width == MaxSize {
+		c.Size.Width = boundries.Width
+	}
+
+	MeasureAll(c.Children, NewWidth(c.columnWidth()), renderer)
+
+	if c.Size.Height == MaxSize {
+		c.Size.Height = boundries.Height
+	}
+
+	if c.Size.Height == 0 {
+		c.Size.Height = c.balancedHeight()
+	}
+}
+
+func (c *Columns) Render(renderer *DocumentRenderer) error {
+	defer renderer.SetOffset(renderer.GetCurrentOffset())
+
+	columns := c.columns
+	if columns == nil {
+		var rest Elements
+		columns, rest = c.pack(c.Children, c.Size.Height, renderer)
+		if len(rest) > 0 {
+			return fmt.Errorf(
+				"Columns: %d elements don't fit %d columns of height %v: %w",
+				len(rest),
+				c.count(),
+				c.Size.Height,
+				ErrElementOverflow,
+			)
+		}
+	}
+
+	origin := renderer.GetCurrentOffset()
+	columnWidth := c.columnWidth()
+	for index, column := range columns {
+		x := origin.X + float64(index)*(columnWidth+c.Gutter)
+		if index > 0 && c.Rule != nil {
+			renderer.DrawVerticalLineWithOffset(
+				origin.Y+c.Size.Height,
+				NewOffset(x-c.Gutter/2, origin.Y),
+				c.Rule,
+			)
+		}
+
+		renderer.SetXY(x, origin.Y)
+		for _, child := range column {
+			if err := child.Render(renderer); err != nil {
+				return err
+			}
+			renderer.AddY(child.GetSize().Height)
+		}
+	}
+
+	return nil
+}
+
+func (c *Columns) Split(
+	height float64,
+	renderer *DocumentRenderer,
+) (Element, Element, bool) {
+	if c.Size.Height <= height {
+		return c, nil, true
+	}
+
+	columns, rest := c.pack(c.Children, height, renderer)
+	if len(columns) == 0 || TotalHeight(columns[0]) > height+sizeTolerance {
+		return nil, nil, false
+	}
+
+	if len(rest) == 0 {
+		return c, nil, true
+	}
+
+	head := c.fragment(slices.Concat(columns...))
+	head.Size.Height = height
+	head.columns = columns
+
+	tail := c.fragment(rest)
+	tail.Size.Height = tail.balancedHeight()
+
+	return head, tail, true
+}
+
+func (c *Columns) fragment(children Elements) *Columns {
+	return &Columns{
+		Size:     NewWidth(c.Size.Width),
+		Count:    c.Count,
+		Gutter:   c.Gutter,
+		Rule:     c.Rule,
+		Children: children,
+	}
+}
+
+func (c *Columns) count() int {
+	if c.Count < 1 {
+		return 1
+	}
+	return c.Count
+}
+
+func (c *Columns) columnWidth() float64 {
+	count := float64(c.count())
+	return (c.Size.Width - c.Gutter*(count-1)) / count
+}
+
+func (c *Columns) pack(
+	children Elements,
+	height float64,
+	renderer *DocumentRenderer,
+) ([]Elements, Elements) {
+	columns := make([]Elements, 0, c.count())
+	queue := slices.Clone(children)
+	for len(columns) < c.count() && len(queue) > 0 {
+		var column Elements
+		var used float64
+		for len(queue) > 0 {
+			child := queue[0]
+			if pageBreak, ok := child.(*PageBreak); ok {
+				queue = queue[1:]
+				if pageBreak.ShouldBreak(height - used) {
+					break
+				}
+				continue
+			}
+
+			childHeight := child.GetSize().Height
+			if used+childHeight <= height+sizeTolerance {
+				column = append(column, child)
+				used += childHeight
+				queue = queue[1:]
+				continue
+			}
+
+			head, tail := splitElement(child, height-used, renderer)
+			if tail != nil {
+				column = append(column, head)
+				queue[0] = tail
+			} else if len(column) == 0 {
+				column = append(column, child)
+				queue = queue[1:]
+			}
+			break
+		}
+		columns = append(columns, column)
+	}
+
+	return columns, queue
+}
+
+func (c *Columns) balancedHeight() float64 {
+	count := c.count()
+	height := max(MaxHeight(c.Children), TotalHeight(c.Children)/float64(count))
+	for {
+		column, used, increase := 0, 0.0, MaxSize
+		broken := false
+		for _, child := range c.Children {
+			if pageBreak, ok := child.(*PageBreak); ok {
+				if broken || !pageBreak.ShouldBreak(height-used) {
+					continue
+				}
+				if pageBreak.MinSpace > 0 {
+					increase = min(increase, used+pageBreak.MinSpace-height)
+				}
+				broken = true
+				continue
+			}
+
+			childHeight := child.GetSize().Height
+			if !broken && used+childHeight <= height+sizeTolerance {
+				used += childHeight
+				continue
+			}
+
+			if !broken {
+				increase = min(increase, used+childHeight-height)
+			}
+			broken = false
+			column++
+			used = childHeight
+			if column >= count {
+				break
+			}
+		}
+
+		if column < count || increase == MaxSize {
+			return height
+		}
+		height += increase
+	}
+}
@@ -0,0 +1,102 @@
+package grpt
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestColumnsBalancedHeight(t *testing.T) {
+	tests := []struct {
+		name     string
+		count    int
+		children Elements
+		want     float64
+	}{
+		{
+			name:  "even split",
+			count: 2,
+			children: Elements{
+				NewVerticalSpace(10),
+				NewVerticalSpace(10),
+				NewVerticalSpace(10),
+				NewVerticalSpace(10),
+			},
+			want: 20,
+		},
+		{
+			name:  "page break starts the next column",
+			count: 2,
+			children: Elements{
+				NewVerticalSpace(10),
+				NewPageBreak(),
+				NewVerticalSpace(10),
+				NewVerticalSpace(10),
+				NewVerticalSpace(10),
+			},
+			want: 30,
+		},
+		{
+			name:  "conditional break grows the height",
+			count: 2,
+			children: Elements{
+				NewVerticalSpace(10),
+				NewConditionalPageBreak(15),
+				NewVerticalSpace(10),
+				NewVerticalSpace(10),
+				NewVerticalSpace(10),
+			},
+			want: 25,
+		},
+		{
+			name:  "unsized text",
+			count: 2,
+			children: Elements{
+				&Text{Value: "first"},
+				&Text{Value: "second"},
+				&Text{Value: "third"},
+				&Text{Value: "fourth"},
+			},
+			want: 16,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			renderer := newTestRenderer(t)
+			columns := &Columns{
+				Size:     NewWidth(200),
+				Count:    test.count,
+				Children: test.children,
+			}
+			columns.Measure(NewSize(200, 500), renderer)
+
+			if columns.Size.Height != test.want {
+				t.Fatalf("height = %v, want %v", columns.Size.Height, test.want)
+			}
+
+			packed, rest := columns.pack(test.children, columns.Size.Height, renderer)
+			if len(rest) > 0 || len(packed) > test.count {
+				t.Fatalf("%d columns and %d leftover elements", len(packed), len(rest))
+			}
+		})
+	}
+}
+
+func TestColumnsRenderOverflow(t *testing.T) {
+	renderer := newTestRenderer(t)
+	columns := &Columns{
+		Size:  NewSize(200, 20),
+		Count: 2,
+		Children: Elements{
+			NewVerticalSpace(20),
+			NewVerticalSpace(20),
+			NewVerticalSpace(20),
+		},
+	}
+	columns.Measure(NewSize(200, 500), renderer)
+
+	err := columns.Render(renderer)
+	if !errors.Is(err, ErrElementOverflow) {
+		t.Fatalf("err = %v, want ErrElementOverflow", err)
+	}
+}
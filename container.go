@@ -1,10 +1,15 @@
 package grpt
 
+import "fmt"
+
 type Container struct {
 	Size             Size
 	Padding          EdgeInsets
 	Border           Border
 	Borders          []Border
+	Background       Paint
+	CornerRadius     CornerRadius
+	Shadow           *BoxShadow
 	ContentAlignment Alignment
 	Child            Element
 
@@ -25,8 +30,17 @@ func (c *Container) Measure(boundries Size, renderer *DocumentRenderer) {
 	c.wasMeasuredAtLeastOnce = true
 
 	c.Size = c.Size.Merge(boundries)
+	if c.Child == nil {
+		return
+	}
+
 	if c.Size.HasZeroValue() {
-		c.Child.Measure(c.Size.WithPadding(c.Padding), renderer)
+		boundries := c.Size.WithPadding(c.Padding)
+		if c.Size.Height == 0 {
+			boundries.Height = 0
+		}
+
+		c.Child.Measure(boundries, renderer)
 		c.Size = c.Size.Merge(c.Child.GetSize().WithoutPadding(c.Padding))
 	} else {
 		c.Child.Measure(c.Size.WithPadding(c.Padding), renderer)
@@ -36,13 +50,32 @@ func (c *Container) Measure(boundries Size, renderer *DocumentRenderer) {
 func (c *Container) Render(document *DocumentRenderer) error {
 	defer document.SetOffset(document.GetCurrentOffset())
 	if c.Size.HasZeroValue() {
-		panic(ErrInvalidSize)
+		return fmt.Errorf(
+			"Container size cannot have width or height of 0: {w:%v, h:%v}: %w",
+			c.Size.Width,
+			c.Size.Height,
+			ErrInvalidSize,
+		)
+	}
+
+	if err := c.renderBackground(document); err != nil {
+		return err
 	}
 
-	if len(c.Borders) > 0 {
-		document.DrawBoxWithBorders(c.Size, c.Borders...)
-	} else if c.Border.Side != 0 {
-		document.DrawBoxWithBorders(c.Size, c.Border)
+	borders := c.Borders
+	if len(borders) == 0 && c.Border.Side != 0 {
+		borders = []Border{c.Border}
+	}
+
+	if len(borders) > 0 {
+		err := document.DrawRoundedBoxWithBorders(c.Size, c.CornerRadius, borders...)
+		if err != nil {
+			return err
+		}
+	}
+
+	if c.Child == nil {
+		return nil
 	}
 
 	paddedSize := c.Size.WithPadding(c.Padding)
@@ -69,3 +102,72 @@ func (c *Container) Render(document *DocumentRenderer) error {
 
 	return c.Child.Render(document)
 }
+
+func (c *Container) renderBackground(document *DocumentRenderer) error {
+	offset := document.GetCurrentOffset()
+	if c.Shadow != nil {
+		shadowSize := NewSize(
+			c.Size.Width+2*c.Shadow.Spread,
+			c.Size.Height+2*c.Shadow.Spread,
+		)
+		document.SetOffset(NewOffset(
+			offset.X+c.Shadow.Offset.X-c.Shadow.Spread,
+			offset.Y+c.Shadow.Offset.Y-c.Shadow.Spread,
+		))
+
+		err := document.FillRectangle(
+			shadowSize,
+			c.CornerRadius,
+			c.Shadow.Color,
+			c.Shadow.opacity(),
+		)
+		document.SetOffset(offset)
+		if err != nil {
+			return err
+		}
+	}
+
+	if c.Background == nil {
+		return nil
+	}
+
+	return document.FillRectangle(c.Size, c.CornerRadius, c.Background, 0)
+}
+
+func (c *Container) Split(
+	height float64,
+	renderer *DocumentRenderer,
+) (Element, Element, bool) {
+	if c.Size.Height <= height {
+		return c, nil, true
+	}
+
+	splittable, ok := c.Child.(SplittableElement)
+	if !ok {
+		return nil, nil, false
+	}
+
+	verticalPadding := c.Padding.Top + c.Padding.Bottom
+	childHead, childTail, ok := splittable.Split(height-verticalPadding, renderer)
+	if !ok || childHead == nil || childTail == nil {
+		return nil, nil, false
+	}
+
+	head := c.fragment(childHead, childHead.GetSize().Height+verticalPadding)
+	tail := c.fragment(childTail, childTail.GetSize().Height+verticalPadding)
+	return head, tail, true
+}
+
+func (c *Container) fragment(child Element, height float64) *Container {
+	return &Container{
+		Size:             NewSize(c.Size.Width, height),
+		Padding:          c.Padding,
+		Border:           c.Border,
+		Borders:          c.Borders,
+		Background:       c.Background,
+		CornerRadius:     c.CornerRadius,
+		Shadow:           c.Shadow,
+		ContentAlignment: c.ContentAlignment,
+		Child:            child,
+	}
+}
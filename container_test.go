@@ -0,0 +1,76 @@
+package grpt
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+)
+
+func TestBoxShadowOpacity(t *testing.T) {
+	tests := []struct {
+		name    string
+		opacity float64
+		want    float64
+	}{
+		{name: "zero value", opacity: 0, want: defaultShadowOpacity},
+		{name: "negative", opacity: -1, want: defaultShadowOpacity},
+		{name: "translucent", opacity: 0.5, want: 0.5},
+		{name: "opaque", opacity: 1, want: 1},
+		{name: "above one", opacity: 2, want: 1},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			shadow := NewBoxShadow(NewOffset(2, 2), NewColor(0, 0, 0), test.opacity)
+			if got := shadow.opacity(); got != test.want {
+				t.Fatalf("expected %v, got %v", test.want, got)
+			}
+		})
+	}
+}
+
+func TestContainerRender(t *testing.T) {
+	tests := []struct {
+		name      string
+		container *Container
+		contains  string
+	}{
+		{
+			name: "zero shadow opacity is translucent",
+			container: &Container{
+				Size:   NewSize(100, 50),
+				Shadow: &BoxShadow{Offset: NewOffset(2, 2)},
+			},
+			contains: fmt.Sprintf("/ca %.2f", defaultShadowOpacity),
+		},
+		{
+			name: "rounded borders and background",
+			container: &Container{
+				Size:         NewSize(100, 50),
+				Background:   NewColor(200, 200, 200),
+				CornerRadius: NewCornerRadius(8),
+				Borders: []Border{
+					NewBorderWithOptions(BorderTop|BorderBottom, LineOptions{StrokeWidth: 1}),
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			renderer := newTestRenderer(t)
+			test.container.Measure(renderer.GetPageSizeWithPadding(), renderer)
+			if err := test.container.Render(renderer); err != nil {
+				t.Fatal(err)
+			}
+
+			data, err := renderer.Write()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Contains(data, []byte(test.contains)) {
+				t.Fatalf("expected the document to contain %q", test.contains)
+			}
+		})
+	}
+}
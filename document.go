@@ -9,7 +9,10 @@ type DocumentHeader struct {
 }
 
 type DocumentBody struct {
-	Elements Elements
+	Columns      int
+	ColumnGutter float64
+	ColumnRule   *LineOptions
+	Elements     Elements
 }
 
 type DocumentFooter struct {
@@ -19,11 +22,13 @@ type DocumentFooter struct {
 }
 
 type Document struct {
-	PageSize Size
-	Padding  EdgeInsets
-	Header   DocumentHeader
-	Body     DocumentBody
-	Footer   DocumentFooter
+	PageSize     Size
+	Padding      EdgeInsets
+	Font         Font
+	FontFamilies []FontFamily
+	Header       DocumentHeader
+	Body         DocumentBody
+	Footer       DocumentFooter
 }
 
 func (d *Document) Write() ([]byte, error) {
@@ -55,8 +60,10 @@ func (d *Document) WritePDF(path string) (error) {
 
 func (d *Document) build() (*DocumentRenderer, error) {
 	renderer := StartNewDocument(RendererOptions{
-		PageSize: d.PageSize,
-		Padding:  d.Padding,
+		PageSize:     d.PageSize,
+		Padding:      d.Padding,
+		Font:         d.Font,
+		FontFamilies: d.FontFamilies,
 	})
 
 	if len(d.Header.Elements) > 0 {
@@ -85,10 +92,23 @@ func (d *Document) build() (*DocumentRenderer, error) {
 		initialBodySize.Height -= renderer.FooterHeight()
 	}
 
+	bodyElements := d.Body.Elements
+	if d.Body.Columns > 1 {
+		bodyElements = Elements{
+			&Columns{
+				Size:     NewMaxWidth(),
+				Count:    d.Body.Columns,
+				Gutter:   d.Body.ColumnGutter,
+				Rule:     d.Body.ColumnRule,
+				Children: d.Body.Elements,
+			},
+		}
+	}
+
 	body := &Column{
 		OverflowMode: OverflowModeContinueOnNextPage,
 		Size:         NewMaxWidth(),
-		Children:     d.Body.Elements,
+		Children:     bodyElements,
 	}
 
 	renderer.OnAddingPage(func(renderer *DocumentRenderer) {
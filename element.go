@@ -19,6 +19,34 @@ type Element interface {
 	Render(renderer *DocumentRenderer) error
 }
 
+type SplittableElement interface {
+	Element
+	Split(height float64, renderer *DocumentRenderer) (Element, Element, bool)
+}
+
+type BaselineElement interface {
+	Element
+	Baseline(renderer *DocumentRenderer) float64
+}
+
+func splitElement(
+	element Element,
+	height float64,
+	renderer *DocumentRenderer,
+) (Element, Element) {
+	splittable, ok := element.(SplittableElement)
+	if !ok {
+		return nil, nil
+	}
+
+	head, tail, ok := splittable.Split(height, renderer)
+	if !ok {
+		return nil, nil
+	}
+
+	return head, tail
+}
+
 type ElementsSummary struct {
 	TotalSize     Size
 	MaxSize       Size
@@ -15,13 +15,17 @@ type Error interface {
 }
 
 var (
-	ErrInvalidArgument   = newError(1, "invalid argument")
-	ErrElementOverflow   = newError(2, "element doesn't fit its parent")
-	ErrInvalidAxis       = newError(3, "invalid axis")
-	ErrInvalidSize       = newError(3, "invalid size")
-	ErrInvalidOffset     = newError(4, "invalid offset")
-	ErrInvalidBorderSide = newError(5, "invalid border side")
-	ErrElementRender     = newError(6, "element can't be renderized")
+	ErrInvalidArgument     = newError(1, "invalid argument")
+	ErrElementOverflow     = newError(2, "element doesn't fit its parent")
+	ErrInvalidAxis         = newError(3, "invalid axis")
+	ErrInvalidSize         = newError(3, "invalid size")
+	ErrInvalidOffset       = newError(4, "invalid offset")
+	ErrInvalidBorderSide   = newError(5, "invalid border side")
+	ErrElementRender       = newError(6, "element can't be renderized")
+	ErrHyphenatorNotFound  = newError(7, "hyphenator not found")
+	ErrUnsupportedGlyph    = newError(8, "unsupported glyph")
+	ErrUnsupportedFont     = newError(9, "unsupported font")
+	ErrUnsupportedOutlines = newError(10, "only TrueType outlines can be embedded")
 )
 
 type baseError struct {
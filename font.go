@@ -2,11 +2,15 @@ package grpt
 
 import (
 	"embed"
+	"fmt"
 	"io/fs"
 	"os"
+	"path"
+	"path/filepath"
 	"strings"
 
 	"github.com/signintech/gopdf"
+	"github.com/signintech/gopdf/fontmaker/core"
 )
 
 //go:embed assets/fonts
@@ -65,35 +69,117 @@ func FontFamilyFromPath(name string, path string) (*FontFamily, error) {
 		Path: path,
 	}
 
+	var familyNames []string
+	families := map[string]*FontFamily{}
 	for _, entry := range entries {
-		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".ttf") {
+		if entry.IsDir() || !isFontFile(entry.Name()) {
 			continue
 		}
 
-		fileName := strings.ToLower(entry.Name())
-		if strings.Contains(fileName, "regular") {
-			fontFamily.Regular = fileName
-			continue
+		fileName := entry.Name()
+		data, err := os.ReadFile(filepath.Join(path, fileName))
+		if err != nil {
+			return nil, err
 		}
 
-		if strings.Contains(fileName, "bolditalic") {
-			fontFamily.BoldItalic = fileName
+		faces, err := parseFontFaces(data)
+		if err != nil {
+			fontFamily.setStyleFromFileName(fileName)
 			continue
 		}
 
-		if strings.Contains(fileName, "italic") {
-			fontFamily.Italic = fileName
-			continue
-		}
+		for index, face := range faces {
+			key := strings.ToLower(face.Family)
+			family, ok := families[key]
+			if !ok {
+				family = &FontFamily{Name: name, Path: path}
+				families[key] = family
+				familyNames = append(familyNames, face.Family)
+			}
 
-		if strings.Contains(fileName, "bold") {
-			fontFamily.Bold = fileName
-			continue
+			if len(family.styleFile(face.Style)) == 0 {
+				family.setStyleFile(face.Style, joinFontFaceIndex(fileName, index))
+			}
 		}
+	}
+
+	if family, ok := families[strings.ToLower(name)]; ok {
+		return family, nil
+	}
+
+	switch len(families) {
+	case 0:
+		return fontFamily, nil
+	case 1:
+		return families[strings.ToLower(familyNames[0])], nil
+	default:
+		return nil, fmt.Errorf(
+			"%s holds the font families %s, none named %q: %w",
+			path,
+			strings.Join(familyNames, ", "),
+			name,
+			ErrInvalidArgument,
+		)
+	}
+}
 
+func (f *FontFamily) setStyleFromFileName(fileName string) {
+	lowerName := strings.ToLower(fileName)
+	switch {
+	case strings.Contains(lowerName, "regular"):
+		f.Regular = fileName
+	case strings.Contains(lowerName, "bolditalic"):
+		f.BoldItalic = fileName
+	case strings.Contains(lowerName, "italic"):
+		f.Italic = fileName
+	case strings.Contains(lowerName, "bold"):
+		f.Bold = fileName
+	}
+}
+
+func (f *FontFamily) styleFile(style int) string {
+	switch style &^ gopdf.Underline {
+	case gopdf.Regular:
+		return f.Regular
+	case gopdf.Italic:
+		return f.Italic
+	case gopdf.Bold:
+		return f.Bold
+	case gopdf.Bold | gopdf.Italic:
+		return f.BoldItalic
+	default:
+		return ""
+	}
+}
+
+func (f *FontFamily) setStyleFile(style int, fileName string) {
+	switch style &^ gopdf.Underline {
+	case gopdf.Regular:
+		f.Regular = fileName
+	case gopdf.Italic:
+		f.Italic = fileName
+	case gopdf.Bold:
+		f.Bold = fileName
+	case gopdf.Bold | gopdf.Italic:
+		f.BoldItalic = fileName
+	}
+}
+
+func (f *FontFamily) readStyle(style int) ([]byte, error) {
+	fileName, index := splitFontFaceIndex(f.styleFile(style))
+
+	var data []byte
+	var err error
+	if f.Source != nil {
+		data, err = fs.ReadFile(f.Source, path.Join(f.Path, fileName))
+	} else {
+		data, err = os.ReadFile(filepath.Join(f.Path, fileName))
+	}
+	if err != nil {
+		return nil, err
 	}
 
-	return fontFamily, nil
+	return fontFaceData(data, index)
 }
 
 func (f *FontFamily) HasRegularStyle() bool {
@@ -113,9 +199,35 @@ func (f *FontFamily) HasBoldItalicStyle() bool {
 }
 
 type Font struct {
-	Family string
-	Size   float64
-	Style  *FontStyle
+	Family    string
+	Size      float64
+	Style     *FontStyle
+	Fallbacks []string
+	Strict    bool
+}
+
+func (f Font) Merge(other Font) Font {
+	if len(f.Family) == 0 {
+		f.Family = other.Family
+	}
+
+	if f.Size == 0 {
+		f.Size = other.Size
+	}
+
+	if f.Style == nil {
+		f.Style = other.Style
+	}
+
+	if f.Fallbacks == nil {
+		f.Fallbacks = other.Fallbacks
+	}
+
+	if !f.Strict {
+		f.Strict = other.Strict
+	}
+
+	return f
 }
 
 type FontStyle struct {
@@ -152,3 +264,31 @@ func (f *FontStyle) Combine() int {
 
 	return combination
 }
+
+type fontMetrics struct {
+	Ascender  float64
+	Descender float64
+	Glyphs    map[int]uint
+}
+
+func parseFontMetrics(data []byte) (fontMetrics, error) {
+	parser := core.TTFParser{}
+	if err := parser.ParseFontData(data); err != nil {
+		return fontMetrics{}, err
+	}
+
+	unitsPerEm := float64(parser.UnitsPerEm())
+	if unitsPerEm == 0 {
+		return fontMetrics{}, nil
+	}
+
+	return fontMetrics{
+		Ascender:  float64(parser.TypoAscender()) / unitsPerEm,
+		Descender: float64(parser.TypoDescender()) / unitsPerEm,
+		Glyphs:    parser.Chars(),
+	}, nil
+}
+
+func fontKey(family string, style int) string {
+	return fmt.Sprintf("%s:%d", family, style)
+}
@@ -0,0 +1,119 @@
+package grpt
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"reflect"
+	"sync"
+)
+
+var sharedFonts = &fontCache{fonts: map[fontCacheKey]*cachedFont{}}
+
+type fontCacheKey struct {
+	source  fs.FS
+	path    string
+	size    int64
+	modTime int64
+}
+
+type cachedFont struct {
+	once    sync.Once
+	data    []byte
+	metrics fontMetrics
+	err     error
+}
+
+type fontCache struct {
+	mutex sync.Mutex
+	fonts map[fontCacheKey]*cachedFont
+}
+
+func ResetFontCache() {
+	sharedFonts.mutex.Lock()
+	defer sharedFonts.mutex.Unlock()
+
+	sharedFonts.fonts = map[fontCacheKey]*cachedFont{}
+}
+
+func (c *fontCache) load(family FontFamily, style int) ([]byte, fontMetrics, error) {
+	key, ok := newFontCacheKey(family, style)
+	if !ok {
+		return readFontStyle(family, style)
+	}
+
+	c.mutex.Lock()
+	font, ok := c.fonts[key]
+	if !ok {
+		font = &cachedFont{}
+		c.fonts[key] = font
+	}
+	c.mutex.Unlock()
+
+	font.once.Do(func() {
+		font.data, font.metrics, font.err = readFontStyle(family, style)
+	})
+
+	if font.err != nil {
+		c.mutex.Lock()
+		if c.fonts[key] == font {
+			delete(c.fonts, key)
+		}
+		c.mutex.Unlock()
+	}
+
+	return font.data, font.metrics, font.err
+}
+
+func newFontCacheKey(family FontFamily, style int) (fontCacheKey, bool) {
+	fileName := family.styleFile(style)
+	if family.Source != nil {
+		if !reflect.TypeOf(family.Source).Comparable() {
+			return fontCacheKey{}, false
+		}
+
+		return fontCacheKey{
+			source: family.Source,
+			path:   path.Join(family.Path, fileName),
+		}, true
+	}
+
+	filePath, _ := splitFontFaceIndex(filepath.Join(family.Path, fileName))
+	info, err := os.Stat(filePath)
+	if err != nil {
+		return fontCacheKey{}, false
+	}
+
+	absolutePath, err := filepath.Abs(filepath.Join(family.Path, fileName))
+	if err != nil {
+		return fontCacheKey{}, false
+	}
+
+	return fontCacheKey{
+		path:    absolutePath,
+		size:    info.Size(),
+		modTime: info.ModTime().UnixNano(),
+	}, true
+}
+
+func readFontStyle(family FontFamily, style int) ([]byte, fontMetrics, error) {
+	data, err := family.readStyle(style)
+	if err != nil {
+		return nil, fontMetrics{}, err
+	}
+
+	if _, ok := sfntTable(data, "glyf"); !ok {
+		return nil, fontMetrics{}, ErrUnsupportedFont.Wrap(
+			fmt.Errorf("font '%s' has no TrueType outlines", family.Name),
+		)
+	}
+
+	metrics, err := parseFontMetrics(data)
+	if err != nil {
+		return nil, fontMetrics{}, err
+	}
+
+	return data, metrics, nil
+}
@@ -0,0 +1,86 @@
+package grpt
+
+import (
+	"bytes"
+	"testing"
+)
+
+func newBenchmarkDocument() *Document {
+	bold := &Font{Style: NewFontStyle(true, false, false)}
+	italic := &Font{Family: "roboto", Style: NewFontStyle(false, true, false)}
+
+	return &Document{
+		PageSize: PageSizeA4,
+		Padding:  NewEdgeInsets(20, 20, 20, 20),
+		Body: DocumentBody{
+			Elements: Elements{
+				&Text{Value: "Boleto de pagamento", Style: TextStyle{Font: bold}},
+				&Text{Value: "Beneficiário: Empresa Exemplo Ltda"},
+				&Text{Value: "Vencimento 10/11/2026", Style: TextStyle{Font: italic}},
+				&Text{Value: "Valor R$ 1.234,56"},
+			},
+		},
+	}
+}
+
+func renderBenchmarkDocument(b *testing.B) {
+	data, err := newBenchmarkDocument().Write()
+	if err != nil {
+		b.Fatal(err)
+	}
+	if !bytes.HasPrefix(data, []byte("%PDF")) {
+		b.Fatal("expected a PDF document")
+	}
+}
+
+func BenchmarkDocument(b *testing.B) {
+	tests := []struct {
+		name  string
+		reset bool
+	}{
+		{name: "cached fonts"},
+		{name: "uncached fonts", reset: true},
+	}
+
+	for _, test := range tests {
+		b.Run(test.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for range b.N {
+				if test.reset {
+					ResetFontCache()
+				}
+				renderBenchmarkDocument(b)
+			}
+		})
+	}
+}
+
+func BenchmarkDocumentParallel(b *testing.B) {
+	b.ReportAllocs()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			renderBenchmarkDocument(b)
+		}
+	})
+}
+
+func TestFontCacheSharesData(t *testing.T) {
+	family, ok := LookupFontFamily("calibri")
+	if !ok {
+		t.Fatal("expected calibri to be registered")
+	}
+
+	first, _, err := sharedFonts.load(family, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	second, _, err := sharedFonts.load(family, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if &first[0] != &second[0] {
+		t.Fatal("expected both loads to share the cached font data")
+	}
+}
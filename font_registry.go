@@ -0,0 +1,208 @@
+package grpt
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"runtime"
+	"slices"
+	"strings"
+	"sync"
+)
+
+var DefaultFontRegistry = NewFontRegistry(standardFontFamilies...)
+
+type FontRegistry struct {
+	mutex    sync.RWMutex
+	families map[string]FontFamily
+}
+
+func NewFontRegistry(families ...FontFamily) *FontRegistry {
+	registry := &FontRegistry{
+		families: map[string]FontFamily{},
+	}
+	registry.Register(families...)
+	return registry
+}
+
+func RegisterFontFamily(families ...FontFamily) {
+	DefaultFontRegistry.Register(families...)
+}
+
+func RegisterFontFile(filePath string) error {
+	return DefaultFontRegistry.RegisterFile(filePath)
+}
+
+func ScanFontDirectory(directory string) error {
+	return DefaultFontRegistry.ScanDirectory(directory)
+}
+
+func ScanSystemFonts() error {
+	return DefaultFontRegistry.ScanSystemFonts()
+}
+
+func LookupFontFamily(name string) (FontFamily, bool) {
+	return DefaultFontRegistry.Lookup(name)
+}
+
+func (r *FontRegistry) Register(families ...FontFamily) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	for _, family := range families {
+		r.families[strings.ToLower(family.Name)] = family
+	}
+}
+
+func (r *FontRegistry) RegisterFile(filePath string) error {
+	absolutePath, err := filepath.Abs(filePath)
+	if err != nil {
+		return err
+	}
+
+	data, err := os.ReadFile(absolutePath)
+	if err != nil {
+		return err
+	}
+
+	faces, err := parseFontFaces(data)
+	if err != nil {
+		return err
+	}
+
+	for index, face := range faces {
+		r.addFace(face, joinFontFaceIndex(absolutePath, index))
+	}
+
+	return nil
+}
+
+func (r *FontRegistry) ScanDirectory(directory string) error {
+	var errs []error
+	err := filepath.WalkDir(
+		directory,
+		func(filePath string, entry fs.DirEntry, err error) error {
+			if err != nil {
+				if filePath == directory {
+					return err
+				}
+				errs = append(errs, err)
+				return nil
+			}
+
+			if entry.IsDir() || !isFontFile(entry.Name()) {
+				return nil
+			}
+
+			err = r.RegisterFile(filePath)
+			if errors.Is(err, ErrUnsupportedOutlines) {
+				return nil
+			}
+			if err != nil {
+				errs = append(errs, fmt.Errorf("%s: %w", filePath, err))
+			}
+			return nil
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	return errors.Join(errs...)
+}
+
+func (r *FontRegistry) ScanSystemFonts() error {
+	var errs []error
+	for _, directory := range SystemFontDirectories() {
+		err := r.ScanDirectory(directory)
+		if err != nil && !os.IsNotExist(err) {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (r *FontRegistry) Lookup(name string) (FontFamily, bool) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	family, ok := r.families[strings.ToLower(name)]
+	return family, ok
+}
+
+func (r *FontRegistry) Families() []FontFamily {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	families := make([]FontFamily, 0, len(r.families))
+	for _, family := range r.families {
+		families = append(families, family)
+	}
+
+	slices.SortFunc(families, func(a, b FontFamily) int {
+		return strings.Compare(strings.ToLower(a.Name), strings.ToLower(b.Name))
+	})
+
+	return families
+}
+
+func (r *FontRegistry) addFace(face fontFace, fileName string) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	key := strings.ToLower(face.Family)
+	family, ok := r.families[key]
+	if !ok {
+		family = FontFamily{Name: face.Family}
+	}
+
+	if family.Source != nil || len(family.Path) > 0 {
+		return
+	}
+
+	if len(family.styleFile(face.Style)) == 0 {
+		family.setStyleFile(face.Style, fileName)
+	}
+	r.families[key] = family
+}
+
+func SystemFontDirectories() []string {
+	home, _ := os.UserHomeDir()
+
+	var directories []string
+	switch runtime.GOOS {
+	case "windows":
+		directories = append(directories,
+			filepath.Join(os.Getenv("WINDIR"), "Fonts"),
+			filepath.Join(os.Getenv("LOCALAPPDATA"), "Microsoft", "Windows", "Fonts"),
+		)
+	case "darwin":
+		directories = append(directories,
+			"/System/Library/Fonts",
+			"/Library/Fonts",
+			filepath.Join(home, "Library", "Fonts"),
+		)
+	default:
+		dataHome := os.Getenv("XDG_DATA_HOME")
+		if len(dataHome) == 0 {
+			dataHome = filepath.Join(home, ".local", "share")
+		}
+		directories = append(directories,
+			filepath.Join(dataHome, "fonts"),
+			filepath.Join(home, ".fonts"),
+		)
+
+		dataDirs := os.Getenv("XDG_DATA_DIRS")
+		if len(dataDirs) == 0 {
+			dataDirs = "/usr/local/share:/usr/share"
+		}
+		for _, directory := range filepath.SplitList(dataDirs) {
+			directories = append(directories, filepath.Join(directory, "fonts"))
+		}
+	}
+
+	return directories
+}
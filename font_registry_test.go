@@ -0,0 +1,129 @@
+package grpt
+
+import (
+	"encoding/binary"
+	"errors"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func writeTestFonts(t *testing.T, directory string, fileNames ...string) {
+	t.Helper()
+	for _, fileName := range fileNames {
+		family := strings.ToLower(strings.Split(fileName, "-")[0])
+		data, err := fs.ReadFile(fonts, path.Join("assets/fonts", family, fileName))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		err = os.WriteFile(filepath.Join(directory, fileName), data, 0o644)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func testCFFFont() []byte {
+	data := make([]byte, 12+16+4)
+	binary.BigEndian.PutUint32(data, 0x4F54544F)
+	binary.BigEndian.PutUint16(data[4:], 1)
+	copy(data[12:], "CFF ")
+	binary.BigEndian.PutUint32(data[12+8:], 12+16)
+	binary.BigEndian.PutUint32(data[12+12:], 4)
+	return data
+}
+
+func TestFontFamilyFromPath(t *testing.T) {
+	tests := []struct {
+		name      string
+		family    string
+		files     []string
+		want      FontFamily
+		wantError bool
+	}{
+		{
+			name:   "single family under another name",
+			family: "body",
+			files:  []string{"Roboto-Regular.ttf", "Roboto-Bold.ttf"},
+			want: FontFamily{
+				Name:    "body",
+				Regular: "Roboto-Regular.ttf",
+				Bold:    "Roboto-Bold.ttf",
+			},
+		},
+		{
+			name:   "picks the named family",
+			family: "Calibri",
+			files: []string{
+				"Roboto-Regular.ttf",
+				"Roboto-Italic.ttf",
+				"Calibri-Regular.ttf",
+				"Calibri-BoldItalic.ttf",
+			},
+			want: FontFamily{
+				Name:       "Calibri",
+				Regular:    "Calibri-Regular.ttf",
+				BoldItalic: "Calibri-BoldItalic.ttf",
+			},
+		},
+		{
+			name:      "several families none named",
+			family:    "body",
+			files:     []string{"Roboto-Regular.ttf", "Calibri-Regular.ttf"},
+			wantError: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			directory := t.TempDir()
+			writeTestFonts(t, directory, test.files...)
+
+			family, err := FontFamilyFromPath(test.family, directory)
+			if test.wantError {
+				if !errors.Is(err, ErrInvalidArgument) {
+					t.Fatalf("expected ErrInvalidArgument, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			test.want.Path = directory
+			if *family != test.want {
+				t.Fatalf("expected %+v, got %+v", test.want, *family)
+			}
+		})
+	}
+}
+
+func TestFontRegistryScanDirectory(t *testing.T) {
+	directory := t.TempDir()
+	writeTestFonts(t, directory, "Roboto-Regular.ttf")
+	cffPath := filepath.Join(directory, "Outlines-Regular.otf")
+	err := os.WriteFile(cffPath, testCFFFont(), 0o644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	registry := NewFontRegistry()
+	err = registry.ScanDirectory(directory)
+	if err != nil {
+		t.Fatalf("expected the CFF font to be skipped, got %v", err)
+	}
+
+	err = registry.RegisterFile(cffPath)
+	if !errors.Is(err, ErrUnsupportedOutlines) {
+		t.Fatalf("expected the CFF font to be rejected, got %v", err)
+	}
+
+	family, ok := registry.Lookup("roboto")
+	if !ok || !family.HasRegularStyle() {
+		t.Fatalf("expected Roboto to be registered, got %+v", family)
+	}
+}
@@ -0,0 +1,285 @@
+package grpt
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+
+	"github.com/signintech/gopdf"
+)
+
+const (
+	sfntCollectionTag  = "ttcf"
+	fontFaceIndexSplit = "#"
+
+	nameFontFamily        = 1
+	nameFontSubfamily     = 2
+	namePlatformUnicode   = 0
+	namePlatformMac       = 1
+	namePlatformWindows   = 3
+	nameLanguageEnglishUS = 0x409
+)
+
+type fontFace struct {
+	Family    string
+	Subfamily string
+	Style     int
+}
+
+func fontFaceCount(data []byte) int {
+	if len(data) >= 12 && string(data[:4]) == sfntCollectionTag {
+		return int(binary.BigEndian.Uint32(data[8:12]))
+	}
+	return 1
+}
+
+func fontFaceData(data []byte, index int) ([]byte, error) {
+	if len(data) < 12 {
+		return nil, ErrUnsupportedFont.Wrap(fmt.Errorf("truncated font data"))
+	}
+
+	if string(data[:4]) != sfntCollectionTag {
+		if index != 0 {
+			return nil, ErrUnsupportedFont.Wrap(
+				fmt.Errorf("face %d requested from a single font file", index),
+			)
+		}
+		return data, nil
+	}
+
+	count := fontFaceCount(data)
+	if index < 0 || index >= count || len(data) < 12+4*count {
+		return nil, ErrUnsupportedFont.Wrap(
+			fmt.Errorf("face %d out of range, collection has %d faces", index, count),
+		)
+	}
+
+	offset := int(binary.BigEndian.Uint32(data[12+4*index:]))
+	return extractSFNT(data, offset)
+}
+
+func extractSFNT(data []byte, offset int) ([]byte, error) {
+	if offset+12 > len(data) {
+		return nil, ErrUnsupportedFont.Wrap(fmt.Errorf("truncated font header"))
+	}
+
+	numTables := int(binary.BigEndian.Uint16(data[offset+4:]))
+	headerSize := 12 + 16*numTables
+	if offset+headerSize > len(data) {
+		return nil, ErrUnsupportedFont.Wrap(fmt.Errorf("truncated table directory"))
+	}
+
+	extracted := make([]byte, headerSize)
+	copy(extracted, data[offset:offset+headerSize])
+
+	for index := range numTables {
+		record := extracted[12+16*index:]
+		tableOffset := int(binary.BigEndian.Uint32(record[8:]))
+		tableLength := int(binary.BigEndian.Uint32(record[12:]))
+		if tableOffset+tableLength > len(data) {
+			return nil, ErrUnsupportedFont.Wrap(fmt.Errorf("truncated font table"))
+		}
+
+		binary.BigEndian.PutUint32(record[8:], uint32(len(extracted)))
+		extracted = append(extracted, data[tableOffset:tableOffset+tableLength]...)
+		for len(extracted)%4 != 0 {
+			extracted = append(extracted, 0)
+		}
+	}
+
+	return extracted, nil
+}
+
+func sfntTable(data []byte, tag string) ([]byte, bool) {
+	if len(data) < 12 {
+		return nil, false
+	}
+
+	numTables := int(binary.BigEndian.Uint16(data[4:]))
+	for index := range numTables {
+		start := 12 + 16*index
+		if start+16 > len(data) {
+			return nil, false
+		}
+
+		record := data[start : start+16]
+		if string(record[:4]) != tag {
+			continue
+		}
+
+		offset := int(binary.BigEndian.Uint32(record[8:]))
+		length := int(binary.BigEndian.Uint32(record[12:]))
+		if offset+length > len(data) {
+			return nil, false
+		}
+		return data[offset : offset+length], true
+	}
+
+	return nil, false
+}
+
+func parseFontFaces(data []byte) ([]fontFace, error) {
+	faces := make([]fontFace, fontFaceCount(data))
+	for index := range faces {
+		faceData, err := fontFaceData(data, index)
+		if err != nil {
+			return nil, err
+		}
+
+		faces[index], err = parseFontFace(faceData)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return faces, nil
+}
+
+func parseFontFace(data []byte) (fontFace, error) {
+	if _, ok := sfntTable(data, "glyf"); !ok {
+		if _, ok := sfntTable(data, "CFF "); ok {
+			return fontFace{}, fmt.Errorf(
+				"%w: CFF outlines found, use the TrueType version of the font",
+				ErrUnsupportedOutlines,
+			)
+		}
+		return fontFace{}, ErrUnsupportedOutlines
+	}
+
+	names, ok := sfntTable(data, "name")
+	if !ok {
+		return fontFace{}, ErrUnsupportedFont.Wrap(fmt.Errorf("missing name table"))
+	}
+
+	face := fontFace{
+		Family:    fontName(names, nameFontFamily),
+		Subfamily: fontName(names, nameFontSubfamily),
+	}
+
+	if len(face.Family) == 0 {
+		return fontFace{}, ErrUnsupportedFont.Wrap(fmt.Errorf("missing family name"))
+	}
+
+	var bold, italic bool
+	if os2, ok := sfntTable(data, "OS/2"); ok && len(os2) >= 64 {
+		selection := binary.BigEndian.Uint16(os2[62:])
+		italic = selection&0x01 != 0
+		bold = selection&0x20 != 0
+	} else if head, ok := sfntTable(data, "head"); ok && len(head) >= 46 {
+		macStyle := binary.BigEndian.Uint16(head[44:])
+		bold = macStyle&0x01 != 0
+		italic = macStyle&0x02 != 0
+	} else {
+		subfamily := strings.ToLower(face.Subfamily)
+		bold = strings.Contains(subfamily, "bold")
+		italic = strings.Contains(subfamily, "italic") ||
+			strings.Contains(subfamily, "oblique")
+	}
+
+	face.Style = gopdf.Regular
+	if bold {
+		face.Style |= gopdf.Bold
+	}
+	if italic {
+		face.Style |= gopdf.Italic
+	}
+
+	return face, nil
+}
+
+func fontName(table []byte, nameID int) string {
+	if len(table) < 6 {
+		return ""
+	}
+
+	count := int(binary.BigEndian.Uint16(table[2:]))
+	storage := int(binary.BigEndian.Uint16(table[4:]))
+
+	var name string
+	bestRank := 0
+	for index := range count {
+		start := 6 + 12*index
+		if start+12 > len(table) {
+			break
+		}
+
+		record := table[start : start+12]
+		if int(binary.BigEndian.Uint16(record[6:])) != nameID {
+			continue
+		}
+
+		platform := binary.BigEndian.Uint16(record)
+		language := binary.BigEndian.Uint16(record[4:])
+		length := int(binary.BigEndian.Uint16(record[8:]))
+		offset := storage + int(binary.BigEndian.Uint16(record[10:]))
+		if offset+length > len(table) {
+			continue
+		}
+
+		var rank int
+		switch {
+		case platform == namePlatformWindows && language == nameLanguageEnglishUS:
+			rank = 4
+		case platform == namePlatformWindows:
+			rank = 3
+		case platform == namePlatformUnicode:
+			rank = 2
+		case platform == namePlatformMac:
+			rank = 1
+		default:
+			continue
+		}
+
+		if rank <= bestRank {
+			continue
+		}
+
+		raw := table[offset : offset+length]
+		if platform == namePlatformMac {
+			runes := make([]rune, len(raw))
+			for index, char := range raw {
+				runes[index] = rune(char)
+			}
+			name = string(runes)
+		} else {
+			units := make([]uint16, len(raw)/2)
+			for index := range units {
+				units[index] = binary.BigEndian.Uint16(raw[2*index:])
+			}
+			name = string(utf16.Decode(units))
+		}
+		bestRank = rank
+	}
+
+	return strings.TrimSpace(name)
+}
+
+func splitFontFaceIndex(fileName string) (string, int) {
+	split := strings.LastIndex(fileName, fontFaceIndexSplit)
+	if split < 0 {
+		return fileName, 0
+	}
+
+	index, err := strconv.Atoi(fileName[split+1:])
+	if err != nil {
+		return fileName, 0
+	}
+	return fileName[:split], index
+}
+
+func joinFontFaceIndex(fileName string, index int) string {
+	if index == 0 {
+		return fileName
+	}
+	return fileName + fontFaceIndexSplit + strconv.Itoa(index)
+}
+
+func isFontFile(fileName string) bool {
+	switch strings.ToLower(fileName[strings.LastIndex(fileName, ".")+1:]) {
+	case "ttf", "otf", "ttc":
+		return true
+	default:
+		return false
+	}
+}
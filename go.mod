@@ -5,6 +5,7 @@ go 1.22.5
 require (
 	github.com/boombuler/barcode v1.0.2
 	github.com/signintech/gopdf v0.28.1
+	golang.org/x/text v0.21.0
 )
 
 require (
@@ -0,0 +1,296 @@
+package grpt
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+)
+
+type GradientStop struct {
+	Offset float64
+	Color  Color
+}
+
+func NewGradientStop(offset float64, color Color) GradientStop {
+	return GradientStop{
+		Offset: offset,
+		Color:  color,
+	}
+}
+
+type LinearGradient struct {
+	Angle float64
+	Stops []GradientStop
+}
+
+func NewLinearGradient(angle float64, stops ...GradientStop) LinearGradient {
+	return LinearGradient{
+		Angle: angle,
+		Stops: stops,
+	}
+}
+
+type RadialGradient struct {
+	Center *Offset
+	Radius float64
+	Stops  []GradientStop
+}
+
+func NewRadialGradient(stops ...GradientStop) RadialGradient {
+	return RadialGradient{Stops: stops}
+}
+
+func (g LinearGradient) fill(
+	renderer *DocumentRenderer,
+	subpaths []shapeSubpath,
+	opacity float64,
+) error {
+	box := newShadingBox(subpaths)
+	if box.Size.HasZeroValue() || len(g.Stops) == 0 {
+		return nil
+	}
+
+	radians := g.Angle * math.Pi / 180
+	direction := NewOffset(math.Cos(radians), math.Sin(radians))
+	center := box.center()
+
+	var half float64
+	for _, corner := range box.corners() {
+		along := (corner.X-center.X)*direction.X + (corner.Y-center.Y)*direction.Y
+		half = max(half, math.Abs(along))
+	}
+
+	start := NewOffset(center.X-direction.X*half, center.Y-direction.Y*half)
+	end := NewOffset(center.X+direction.X*half, center.Y+direction.Y*half)
+	shading := fmt.Sprintf(
+		"<< /ShadingType 2 /ColorSpace /DeviceRGB /Coords [%s %s] /Function %s /Extend [true true] >>",
+		box.point(start),
+		box.point(end),
+		gradientFunction(g.Stops),
+	)
+
+	return renderer.drawShading(box, subpaths, shading, opacity)
+}
+
+func (g RadialGradient) fill(
+	renderer *DocumentRenderer,
+	subpaths []shapeSubpath,
+	opacity float64,
+) error {
+	box := newShadingBox(subpaths)
+	if box.Size.HasZeroValue() || len(g.Stops) == 0 {
+		return nil
+	}
+
+	center := box.center()
+	if g.Center != nil {
+		center = NewOffset(
+			box.Origin.X+box.Size.Width*g.Center.X,
+			box.Origin.Y+box.Size.Height*g.Center.Y,
+		)
+	}
+
+	radius := g.Radius
+	if radius <= 0 {
+		for _, corner := range box.corners() {
+			radius = max(radius, math.Hypot(corner.X-center.X, corner.Y-center.Y))
+		}
+	}
+
+	shading := fmt.Sprintf(
+		"<< /ShadingType 3 /ColorSpace /DeviceRGB /Coords [%s 0 %s %s] /Function %s /Extend [true true] >>",
+		box.point(center),
+		box.point(center),
+		pdfNumber(radius),
+		gradientFunction(g.Stops),
+	)
+
+	return renderer.drawShading(box, subpaths, shading, opacity)
+}
+
+func gradientFunction(stops []GradientStop) string {
+	sorted := slices.Clone(stops)
+	for index := range sorted {
+		sorted[index].Offset = max(0, min(sorted[index].Offset, 1))
+	}
+	slices.SortStableFunc(sorted, func(a, b GradientStop) int {
+		switch {
+		case a.Offset < b.Offset:
+			return -1
+		case a.Offset > b.Offset:
+			return 1
+		default:
+			return 0
+		}
+	})
+
+	first, last := sorted[0], sorted[len(sorted)-1]
+	if len(sorted) == 1 {
+		sorted = []GradientStop{NewGradientStop(0, first.Color), NewGradientStop(1, last.Color)}
+	}
+	if sorted[0].Offset > 0 {
+		sorted = slices.Insert(sorted, 0, NewGradientStop(0, first.Color))
+	}
+	if sorted[len(sorted)-1].Offset < 1 {
+		sorted = append(sorted, NewGradientStop(1, last.Color))
+	}
+
+	functions := make([]string, len(sorted)-1)
+	for index := range functions {
+		functions[index] = fmt.Sprintf(
+			"<< /FunctionType 2 /Domain [0 1] /C0 [%s] /C1 [%s] /N 1 >>",
+			pdfColor(sorted[index].Color),
+			pdfColor(sorted[index+1].Color),
+		)
+	}
+
+	if len(functions) == 1 {
+		return functions[0]
+	}
+
+	bounds := make([]string, len(functions)-1)
+	encode := make([]string, len(functions))
+	for index := range functions {
+		encode[index] = "0 1"
+		if index > 0 {
+			bounds[index-1] = pdfNumber(sorted[index].Offset)
+		}
+	}
+
+	return fmt.Sprintf(
+		"<< /FunctionType 3 /Domain [0 1] /Functions [%s] /Bounds [%s] /Encode [%s] >>",
+		strings.Join(functions, " "),
+		strings.Join(bounds, " "),
+		strings.Join(encode, " "),
+	)
+}
+
+type shadingBox struct {
+	Origin Offset
+	Size   Size
+}
+
+func newShadingBox(subpaths []shapeSubpath) shadingBox {
+	origin, size := subpathBounds(subpaths)
+	return shadingBox{Origin: origin, Size: size}
+}
+
+func (b shadingBox) center() Offset {
+	return NewOffset(b.Origin.X+b.Size.Width/2, b.Origin.Y+b.Size.Height/2)
+}
+
+func (b shadingBox) corners() []Offset {
+	return []Offset{
+		b.Origin,
+		NewOffset(b.Origin.X+b.Size.Width, b.Origin.Y),
+		NewOffset(b.Origin.X+b.Size.Width, b.Origin.Y+b.Size.Height),
+		NewOffset(b.Origin.X, b.Origin.Y+b.Size.Height),
+	}
+}
+
+func (b shadingBox) point(point Offset) string {
+	return pdfNumber(point.X-b.Origin.X) + " " +
+		pdfNumber(b.Origin.Y+b.Size.Height-point.Y)
+}
+
+func shadingDocument(
+	box shadingBox,
+	subpaths []shapeSubpath,
+	shading string,
+	opacity float64,
+) []byte {
+	var content strings.Builder
+	resources := "/Shading << /Sh0 " + shading + " >>"
+	if opacity > 0 && opacity < 1 {
+		resources += fmt.Sprintf(
+			" /ExtGState << /GS0 << /Type /ExtGState /ca %s /CA %s >> >>",
+			pdfNumber(opacity),
+			pdfNumber(opacity),
+		)
+		content.WriteString("/GS0 gs\n")
+	}
+
+	for _, subpath := range subpaths {
+		for index, point := range subpath.Points {
+			operator := "l"
+			if index == 0 {
+				operator = "m"
+			}
+			fmt.Fprintf(&content, "%s %s\n", box.point(point), operator)
+		}
+		content.WriteString("h\n")
+	}
+	content.WriteString("W n\n/Sh0 sh\n")
+
+	objects := []string{
+		"<< /Type /Catalog /Pages 2 0 R >>",
+		"<< /Type /Pages /Kids [3 0 R] /Count 1 >>",
+		fmt.Sprintf(
+			"<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %s %s] /Resources << %s >> /Contents 4 0 R >>",
+			pdfNumber(box.Size.Width),
+			pdfNumber(box.Size.Height),
+			resources,
+		),
+		fmt.Sprintf(
+			"<< /Length %d >>\nstream\n%sendstream",
+			content.Len(),
+			content.String(),
+		),
+	}
+
+	var document bytes.Buffer
+	document.WriteString("%PDF-1.4\n")
+	offsets := make([]int, len(objects))
+	for index, object := range objects {
+		offsets[index] = document.Len()
+		fmt.Fprintf(&document, "%d 0 obj\n%s\nendobj\n", index+1, object)
+	}
+
+	xref := document.Len()
+	fmt.Fprintf(&document, "xref\n0 %d\n0000000000 65535 f \n", len(objects)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&document, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(
+		&document,
+		"trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n",
+		len(objects)+1,
+		xref,
+	)
+
+	return document.Bytes()
+}
+
+func subpathBounds(subpaths []shapeSubpath) (Offset, Size) {
+	minimum := NewOffset(math.Inf(1), math.Inf(1))
+	maximum := NewOffset(math.Inf(-1), math.Inf(-1))
+	for _, subpath := range subpaths {
+		for _, point := range subpath.Points {
+			minimum = NewOffset(min(minimum.X, point.X), min(minimum.Y, point.Y))
+			maximum = NewOffset(max(maximum.X, point.X), max(maximum.Y, point.Y))
+		}
+	}
+
+	if math.IsInf(minimum.X, 0) {
+		return Offset{}, Size{}
+	}
+
+	return minimum, NewSize(maximum.X-minimum.X, maximum.Y-minimum.Y)
+}
+
+func pdfNumber(value float64) string {
+	number := strings.TrimRight(fmt.Sprintf("%.4f", value), "0")
+	number = strings.TrimSuffix(number, ".")
+	if number == "-0" {
+		return "0"
+	}
+	return number
+}
+
+func pdfColor(color Color) string {
+	return pdfNumber(float64(color.R)/255) + " " +
+		pdfNumber(float64(color.G)/255) + " " +
+		pdfNumber(float64(color.B)/255)
+}
@@ -0,0 +1,138 @@
+package grpt
+
+import (
+	"bytes"
+	"compress/zlib"
+	"io"
+	"testing"
+)
+
+func TestGradientFunction(t *testing.T) {
+	red, green, blue := NewColor(255, 0, 0), NewColor(0, 255, 0), NewColor(0, 0, 255)
+	tests := []struct {
+		name  string
+		stops []GradientStop
+		want  string
+	}{
+		{
+			name:  "single stop",
+			stops: []GradientStop{NewGradientStop(0.3, red)},
+			want:  "<< /FunctionType 2 /Domain [0 1] /C0 [1 0 0] /C1 [1 0 0] /N 1 >>",
+		},
+		{
+			name:  "two stops",
+			stops: []GradientStop{NewGradientStop(0, red), NewGradientStop(1, blue)},
+			want:  "<< /FunctionType 2 /Domain [0 1] /C0 [1 0 0] /C1 [0 0 1] /N 1 >>",
+		},
+		{
+			name: "unsorted stops are padded to the domain",
+			stops: []GradientStop{
+				NewGradientStop(0.75, blue),
+				NewGradientStop(0.25, red),
+				NewGradientStop(0.5, green),
+			},
+			want: "<< /FunctionType 3 /Domain [0 1] /Functions [" +
+				"<< /FunctionType 2 /Domain [0 1] /C0 [1 0 0] /C1 [1 0 0] /N 1 >> " +
+				"<< /FunctionType 2 /Domain [0 1] /C0 [1 0 0] /C1 [0 1 0] /N 1 >> " +
+				"<< /FunctionType 2 /Domain [0 1] /C0 [0 1 0] /C1 [0 0 1] /N 1 >> " +
+				"<< /FunctionType 2 /Domain [0 1] /C0 [0 0 1] /C1 [0 0 1] /N 1 >>" +
+				"] /Bounds [0.25 0.5 0.75] /Encode [0 1 0 1 0 1 0 1] >>",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := gradientFunction(test.stops); got != test.want {
+				t.Fatalf("expected\n%s\ngot\n%s", test.want, got)
+			}
+		})
+	}
+}
+
+func TestGradientFill(t *testing.T) {
+	stops := []GradientStop{
+		NewGradientStop(0, NewColor(255, 255, 255)),
+		NewGradientStop(1, NewColor(0, 0, 0)),
+	}
+
+	tests := []struct {
+		name    string
+		paint   Paint
+		opacity float64
+		want    []string
+	}{
+		{
+			name:  "linear",
+			paint: NewLinearGradient(0, stops...),
+			want:  []string{"/ShadingType 2", "/Coords [0 25 100 25 ]"},
+		},
+		{
+			name:  "vertical linear",
+			paint: NewLinearGradient(90, stops...),
+			want:  []string{"/ShadingType 2", "/Coords [50 50 50 0 ]"},
+		},
+		{
+			name:    "translucent radial",
+			paint:   NewRadialGradient(stops...),
+			opacity: 0.5,
+			want:    []string{"/ShadingType 3", "/ca 0.5", "/GS0 gs"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			renderer := newTestRenderer(t)
+			for range 2 {
+				err := renderer.DrawRectangle(
+					NewSize(100, 50),
+					CornerRadius{},
+					ShapeOptions{Fill: test.paint, Opacity: test.opacity},
+				)
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			if len(renderer.shadings) != 1 {
+				t.Fatalf("expected one shared shading, got %d", len(renderer.shadings))
+			}
+
+			data, err := renderer.Write()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			document := inflatePDFStreams(t, data)
+			for _, want := range test.want {
+				if !bytes.Contains(document, []byte(want)) {
+					t.Fatalf("expected the document to contain %q", want)
+				}
+			}
+		})
+	}
+}
+
+func inflatePDFStreams(t *testing.T, data []byte) []byte {
+	t.Helper()
+
+	document := bytes.Clone(data)
+	for rest := data; ; {
+		start := bytes.Index(rest, []byte("stream\n"))
+		if start < 0 {
+			return document
+		}
+		rest = rest[start+len("stream\n"):]
+
+		end := bytes.Index(rest, []byte("endstream"))
+		if end < 0 {
+			return document
+		}
+
+		reader, err := zlib.NewReader(bytes.NewReader(rest[:end]))
+		if err == nil {
+			inflated, _ := io.ReadAll(reader)
+			document = append(document, inflated...)
+		}
+		rest = rest[end:]
+	}
+}
@@ -0,0 +1,680 @@
+package grpt
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"image"
+	"io"
+	"net/url"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+const (
+	defaultHTMLSpacing float64 = 6
+	pixelsToPoints     float64 = 0.75
+)
+
+var htmlNamedColors = map[string]Color{
+	"black":  NewColor(0, 0, 0),
+	"white":  NewColor(255, 255, 255),
+	"red":    NewColor(255, 0, 0),
+	"green":  NewColor(0, 128, 0),
+	"blue":   NewColor(0, 0, 255),
+	"gray":   NewColor(128, 128, 128),
+	"grey":   NewColor(128, 128, 128),
+	"silver": NewColor(192, 192, 192),
+	"maroon": NewColor(128, 0, 0),
+	"navy":   NewColor(0, 0, 128),
+	"orange": NewColor(255, 165, 0),
+	"yellow": NewColor(255, 255, 0),
+	"purple": NewColor(128, 0, 128),
+}
+
+type htmlNode struct {
+	tag      string
+	attrs    map[string]string
+	text     string
+	children []*htmlNode
+}
+
+type HTML struct {
+	Size    Size
+	Source  string
+	Theme   TextTheme
+	Spacing float64
+
+	root                   *htmlNode
+	err                    error
+	flow                   *blockFlow
+	builtWidth             float64
+	wasMeasuredAtLeastOnce bool
+	originalSize           Size
+}
+
+func NewHTML(source string) (*HTML, error) {
+	root, err := parseHTML(source)
+	if err != nil {
+		return nil, err
+	}
+
+	return &HTML{Source: source, root: root}, nil
+}
+
+func (h HTML) GetSize() Size {
+	return h.Size
+}
+
+func (h *HTML) Measure(boundries Size, renderer *DocumentRenderer) {
+	if h.wasMeasuredAtLeastOnce {
+		h.Size = h.originalSize
+	} else {
+		h.originalSize = h.Size
+	}
+	h.wasMeasuredAtLeastOnce = true
+
+	if h.Size.Width == 0 || h.Size.Width == MaxSize {
+		h.Size.Width = boundries.Width
+	}
+
+	if h.root == nil && h.flow == nil {
+		h.root, h.err = parseHTML(h.Source)
+	}
+
+	if h.flow == nil || h.root != nil && h.builtWidth != h.Size.Width {
+		h.flow = &blockFlow{}
+		h.builtWidth = h.Size.Width
+		if h.root != nil {
+			h.buildBlocks(h.root, h.Theme.Style(ThemeParagraph), renderer)
+		}
+	}
+
+	height := h.flow.measure(h.Size.Width, renderer)
+	if h.Size.Height == 0 || h.Size.Height == MaxSize {
+		h.Size.Height = height
+	}
+}
+
+func (h *HTML) Render(renderer *DocumentRenderer) error {
+	if h.flow == nil {
+		h.Measure(GetAvailableSpace(renderer.Context()), renderer)
+	}
+
+	if h.err != nil {
+		return fmt.Errorf("HTML.Render: %w", ErrElementRender.Wrap(h.err))
+	}
+	return h.flow.render(renderer)
+}
+
+func (h *HTML) Split(
+	height float64,
+	renderer *DocumentRenderer,
+) (Element, Element, bool) {
+	if h.flow == nil {
+		h.Measure(GetAvailableSpace(renderer.Context()), renderer)
+	}
+
+	if h.Size.Height <= height {
+		return h, nil, true
+	}
+
+	head, tail, ok := h.flow.split(height, renderer)
+	if !ok || tail == nil {
+		return h, nil, ok
+	}
+
+	headHTML := h.fragment(head)
+	headHTML.Measure(NewWidth(h.Size.Width), renderer)
+
+	tailHTML := h.fragment(tail)
+	tailHTML.Measure(NewWidth(h.Size.Width), renderer)
+
+	return headHTML, tailHTML, true
+}
+
+func (h *HTML) fragment(flow *blockFlow) *HTML {
+	return &HTML{
+		Theme:   h.Theme,
+		Spacing: h.Spacing,
+		flow:    flow,
+	}
+}
+
+func (h *HTML) spacing() float64 {
+	if h.Spacing == 0 {
+		return defaultHTMLSpacing
+	}
+	return h.Spacing
+}
+
+func (h *HTML) buildBlocks(
+	node *htmlNode,
+	style TextStyle,
+	renderer *DocumentRenderer,
+) {
+	var inline []*htmlNode
+	flush := func() {
+		if hasHTMLText(inline) {
+			h.flow.add(h.richText(inline, style), h.spacing())
+		}
+		inline = nil
+	}
+
+	for _, child := range node.children {
+		switch child.tag {
+		case "p", "h1", "h2", "h3", "h4", "h5", "h6":
+			flush()
+			h.buildBlocks(child, h.blockStyle(child, h.Theme.Style(child.tag)), renderer)
+		case "div", "section", "article", "header", "footer", "body", "html":
+			flush()
+			h.buildBlocks(child, h.blockStyle(child, style), renderer)
+		case "ul", "ol":
+			flush()
+			h.flow.add(h.list(child, style), h.spacing())
+		case "table":
+			flush()
+			h.table(child, renderer)
+		case "img":
+			flush()
+			if img := h.image(child); img != nil {
+				h.flow.add(img, h.spacing())
+			}
+		case "head", "style", "script", "title":
+		default:
+			inline = append(inline, child)
+		}
+	}
+
+	flush()
+}
+
+func (h *HTML) blockStyle(node *htmlNode, style TextStyle) TextStyle {
+	css := parseInlineCSS(node.attrs["style"])
+	if len(css) == 0 {
+		return style
+	}
+
+	state := newHTMLInlineState(style)
+	state.apply(css)
+	style.Font = &state.font
+	style.Color = state.color
+
+	switch css["text-align"] {
+	case "center":
+		style.Alignment = HorizontalCenterAlignment
+	case "right":
+		style.Alignment = RightAlignment
+	case "justify":
+		style.Alignment = JustifiedAlignment
+	case "left":
+		style.Alignment = LeftAlignment
+	}
+
+	return style
+}
+
+func (h *HTML) richText(nodes []*htmlNode, style TextStyle) *RichText {
+	style.Multiline = true
+	style.WordWrap = true
+
+	builder := htmlSpanBuilder{theme: h.Theme, lastWasSpace: true}
+	for _, node := range nodes {
+		builder.walk(node, newHTMLInlineState(style))
+	}
+
+	spans := builder.spans
+	if len(spans) > 0 {
+		last := &spans[len(spans)-1]
+		last.Text = strings.TrimRightFunc(last.Text, unicode.IsSpace)
+	}
+
+	return &RichText{Style: style, Spans: spans}
+}
+
+func (h *HTML) list(node *htmlNode, style TextStyle) *List {
+	list := &List{Marker: ListMarkerBullet, Spacing: 2}
+	if node.tag == "ol" {
+		list.Marker = ListMarkerDecimal
+		list.Start, _ = strconv.Atoi(node.attrs["start"])
+	}
+
+	itemStyle := h.Theme.Style(ThemeListItem).Merge(style)
+	list.MarkerStyle = itemStyle
+
+	for _, child := range node.children {
+		if child.tag != "li" {
+			continue
+		}
+
+		var inline []*htmlNode
+		item := ListItem{}
+		for _, content := range child.children {
+			if content.tag == "ul" || content.tag == "ol" {
+				if item.Children == nil {
+					item.Children = h.list(content, style)
+				}
+				continue
+			}
+			inline = append(inline, content)
+		}
+
+		item.Content = h.richText(inline, h.blockStyle(child, itemStyle))
+		list.Items = append(list.Items, item)
+	}
+
+	return list
+}
+
+func (h *HTML) table(node *htmlNode, renderer *DocumentRenderer) {
+	first := true
+	for _, row := range findHTMLNodes(node, "tr") {
+		var cells []*RichText
+		for _, cell := range row.children {
+			if cell.tag != "td" && cell.tag != "th" {
+				continue
+			}
+
+			style := h.blockStyle(cell, h.Theme.Style(cell.tag))
+			cells = append(cells, h.richText(cell.children, style))
+		}
+
+		if len(cells) == 0 {
+			continue
+		}
+
+		spacing := 0.0
+		if first {
+			spacing = h.spacing()
+			first = false
+		}
+
+		h.flow.add(newTableRow(h.Size.Width, cells, renderer), spacing)
+	}
+}
+
+func (h *HTML) image(node *htmlNode) *Image {
+	source := node.attrs["src"]
+	if !strings.HasPrefix(source, "data:") {
+		return nil
+	}
+
+	data, err := decodeDataURI(source)
+	if err != nil {
+		return nil
+	}
+
+	size := NewSize(
+		parseCSSLength(node.attrs["width"]),
+		parseCSSLength(node.attrs["height"]),
+	)
+
+	css := parseInlineCSS(node.attrs["style"])
+	if width, ok := css["width"]; ok {
+		size.Width = parseCSSLength(width)
+	}
+	if height, ok := css["height"]; ok {
+		size.Height = parseCSSLength(height)
+	}
+
+	if size.HasZeroValue() {
+		config, _, err := image.DecodeConfig(bytes.NewReader(data))
+		if err != nil {
+			return nil
+		}
+
+		natural := NewSize(
+			float64(config.Width)*pixelsToPoints,
+			float64(config.Height)*pixelsToPoints,
+		)
+
+		switch {
+		case size.Width == 0 && size.Height == 0:
+			size = natural
+		case size.Width == 0:
+			size.Width = natural.Width * size.Height / natural.Height
+		case size.Height == 0:
+			size.Height = natural.Height * size.Width / natural.Width
+		}
+	}
+
+	return NewImage(data, size)
+}
+
+type htmlInlineState struct {
+	font          Font
+	color         *Color
+	underline     bool
+	strikethrough bool
+	link          string
+}
+
+func newHTMLInlineState(style TextStyle) htmlInlineState {
+	state := htmlInlineState{color: style.Color}
+	if style.Font != nil {
+		state.font = *style.Font
+	}
+	state.strikethrough = style.Strikethrough
+	return state
+}
+
+func (s *htmlInlineState) fontStyle() FontStyle {
+	if s.font.Style == nil {
+		return FontStyle{}
+	}
+	return *s.font.Style
+}
+
+func (s *htmlInlineState) setBold(bold bool) {
+	fontStyle := s.fontStyle()
+	fontStyle.Bold = bold
+	s.font.Style = &fontStyle
+}
+
+func (s *htmlInlineState) setItalic(italic bool) {
+	fontStyle := s.fontStyle()
+	fontStyle.Italic = italic
+	s.font.Style = &fontStyle
+}
+
+func (s *htmlInlineState) apply(css map[string]string) {
+	if value, ok := css["color"]; ok {
+		if color, ok := parseCSSColor(value); ok {
+			s.color = &color
+		}
+	}
+
+	if value, ok := css["font-size"]; ok {
+		if size := parseCSSLength(value); size > 0 {
+			s.font.Size = size
+		}
+	}
+
+	if value, ok := css["font-family"]; ok {
+		family := strings.Split(value, ",")[0]
+		s.font.Family = strings.ToLower(strings.Trim(family, `"' `))
+	}
+
+	switch weight := css["font-weight"]; weight {
+	case "bold", "bolder", "600", "700", "800", "900":
+		s.setBold(true)
+	case "normal", "lighter", "100", "200", "300", "400", "500":
+		s.setBold(false)
+	}
+
+	switch css["font-style"] {
+	case "italic", "oblique":
+		s.setItalic(true)
+	case "normal":
+		s.setItalic(false)
+	}
+
+	decoration := css["text-decoration"]
+	if strings.Contains(decoration, "underline") {
+		s.underline = true
+	}
+	if strings.Contains(decoration, "line-through") {
+		s.strikethrough = true
+	}
+	if decoration == "none" {
+		s.underline = false
+		s.strikethrough = false
+	}
+}
+
+type htmlSpanBuilder struct {
+	theme        TextTheme
+	spans        []TextSpan
+	lastWasSpace bool
+}
+
+func (b *htmlSpanBuilder) walk(node *htmlNode, state htmlInlineState) {
+	if len(node.tag) == 0 {
+		b.addText(node.text, state)
+		return
+	}
+
+	switch node.tag {
+	case "br":
+		b.addSpan("\n", state)
+		b.lastWasSpace = true
+		return
+	case "b", "strong":
+		state.setBold(true)
+	case "i", "em":
+		state.setItalic(true)
+	case "u", "ins":
+		state.underline = true
+	case "s", "strike", "del":
+		state.strikethrough = true
+	case "a":
+		state.link = node.attrs["href"]
+		state.underline = true
+		if color := b.theme.Style(ThemeLink).Color; color != nil {
+			state.color = color
+		}
+	case "code":
+		code := b.theme.Style(ThemeCode)
+		if code.Font != nil {
+			state.font = code.Font.Merge(state.font)
+		}
+		if code.Color != nil {
+			state.color = code.Color
+		}
+	case "script", "style":
+		return
+	}
+
+	state.apply(parseInlineCSS(node.attrs["style"]))
+	if color, ok := parseCSSColor(node.attrs["color"]); ok {
+		state.color = &color
+	}
+
+	for _, child := range node.children {
+		b.walk(child, state)
+	}
+}
+
+func (b *htmlSpanBuilder) addText(text string, state htmlInlineState) {
+	var collapsed strings.Builder
+	for _, char := range text {
+		if unicode.IsSpace(char) {
+			if !b.lastWasSpace {
+				collapsed.WriteRune(' ')
+			}
+			b.lastWasSpace = true
+			continue
+		}
+
+		collapsed.WriteRune(char)
+		b.lastWasSpace = false
+	}
+
+	b.addSpan(collapsed.String(), state)
+}
+
+func (b *htmlSpanBuilder) addSpan(text string, state htmlInlineState) {
+	if len(text) == 0 {
+		return
+	}
+
+	font := state.font
+	b.spans = append(b.spans, TextSpan{
+		Text:          text,
+		Font:          &font,
+		Color:         state.color,
+		Underline:     state.underline,
+		Strikethrough: state.strikethrough,
+		Link:          state.link,
+	})
+}
+
+func parseHTML(source string) (*htmlNode, error) {
+	decoder := xml.NewDecoder(strings.NewReader(source))
+	decoder.Strict = false
+	decoder.AutoClose = xml.HTMLAutoClose
+	decoder.Entity = xml.HTMLEntity
+
+	root := &htmlNode{tag: "body", attrs: map[string]string{}}
+	stack := []*htmlNode{root}
+	for {
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		parent := stack[len(stack)-1]
+		switch t := token.(type) {
+		case xml.StartElement:
+			node := &htmlNode{
+				tag:   strings.ToLower(t.Name.Local),
+				attrs: map[string]string{},
+			}
+
+			for _, attr := range t.Attr {
+				node.attrs[strings.ToLower(attr.Name.Local)] = attr.Value
+			}
+
+			parent.children = append(parent.children, node)
+			stack = append(stack, node)
+		case xml.EndElement:
+			tag := strings.ToLower(t.Name.Local)
+			for index := len(stack) - 1; index > 0; index-- {
+				if stack[index].tag == tag {
+					stack = stack[:index]
+					break
+				}
+			}
+		case xml.CharData:
+			parent.children = append(parent.children, &htmlNode{text: string(t)})
+		}
+	}
+
+	return root, nil
+}
+
+func hasHTMLText(nodes []*htmlNode) bool {
+	for _, node := range nodes {
+		if len(strings.TrimSpace(node.text)) > 0 || node.tag == "br" {
+			return true
+		}
+
+		if hasHTMLText(node.children) {
+			return true
+		}
+	}
+	return false
+}
+
+func findHTMLNodes(node *htmlNode, tag string) []*htmlNode {
+	var nodes []*htmlNode
+	for _, child := range node.children {
+		if child.tag == tag {
+			nodes = append(nodes, child)
+			continue
+		}
+
+		if child.tag != "table" {
+			nodes = append(nodes, findHTMLNodes(child, tag)...)
+		}
+	}
+	return nodes
+}
+
+func parseInlineCSS(value string) map[string]string {
+	css := map[string]string{}
+	for _, declaration := range strings.Split(value, ";") {
+		property, value, ok := strings.Cut(declaration, ":")
+		if !ok {
+			continue
+		}
+
+		property = strings.ToLower(strings.TrimSpace(property))
+		css[property] = strings.ToLower(strings.TrimSpace(value))
+	}
+	return css
+}
+
+func parseCSSLength(value string) float64 {
+	value = strings.TrimSpace(strings.ToLower(value))
+	scale := pixelsToPoints
+	switch {
+	case strings.HasSuffix(value, "px"):
+		value = strings.TrimSuffix(value, "px")
+	case strings.HasSuffix(value, "pt"):
+		value = strings.TrimSuffix(value, "pt")
+		scale = 1
+	}
+
+	length, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+	if err != nil {
+		return 0
+	}
+	return length * scale
+}
+
+func parseCSSColor(value string) (Color, bool) {
+	value = strings.TrimSpace(strings.ToLower(value))
+	if color, ok := htmlNamedColors[value]; ok {
+		return color, true
+	}
+
+	if strings.HasPrefix(value, "#") {
+		hex := value[1:]
+		if len(hex) == 3 {
+			hex = string([]byte{hex[0], hex[0], hex[1], hex[1], hex[2], hex[2]})
+		}
+
+		if len(hex) != 6 {
+			return Color{}, false
+		}
+
+		rgb, err := strconv.ParseUint(hex, 16, 32)
+		if err != nil {
+			return Color{}, false
+		}
+
+		return NewColor(uint8(rgb>>16), uint8(rgb>>8), uint8(rgb)), true
+	}
+
+	if strings.HasPrefix(value, "rgb(") && strings.HasSuffix(value, ")") {
+		parts := strings.Split(value[4:len(value)-1], ",")
+		if len(parts) != 3 {
+			return Color{}, false
+		}
+
+		var channels [3]uint8
+		for index, part := range parts {
+			channel, err := strconv.Atoi(strings.TrimSpace(part))
+			if err != nil {
+				return Color{}, false
+			}
+			channels[index] = uint8(min(max(channel, 0), 255))
+		}
+
+		return NewColor(channels[0], channels[1], channels[2]), true
+	}
+
+	return Color{}, false
+}
+
+func decodeDataURI(uri string) ([]byte, error) {
+	header, data, ok := strings.Cut(strings.TrimPrefix(uri, "data:"), ",")
+	if !ok {
+		return nil, fmt.Errorf("invalid data URI: %w", ErrInvalidArgument)
+	}
+
+	if strings.HasSuffix(header, ";base64") {
+		return base64.StdEncoding.DecodeString(strings.TrimSpace(data))
+	}
+
+	decoded, err := url.PathUnescape(data)
+	return []byte(decoded), err
+}
@@ -0,0 +1,94 @@
+package grpt
+
+import (
+	"bytes"
+	"encoding/base64"
+	"image"
+	"image/png"
+	"testing"
+)
+
+func testPNGDataURI(t *testing.T, width, height int) string {
+	t.Helper()
+
+	var buffer bytes.Buffer
+	err := png.Encode(&buffer, image.NewRGBA(image.Rect(0, 0, width, height)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return "data:image/png;base64," + base64.StdEncoding.EncodeToString(buffer.Bytes())
+}
+
+func TestHTMLImageSources(t *testing.T) {
+	pixel := testPNGDataURI(t, 4, 2)
+
+	tests := []struct {
+		name     string
+		source   string
+		wantSize *Size
+	}{
+		{"data URI", `<img src="` + pixel + `">`, &Size{Width: 3, Height: 1.5}},
+		{"data URI with width", `<img src="` + pixel + `" width="40">`, &Size{Width: 30, Height: 15}},
+		{"local path", `<img src="/etc/hostname">`, nil},
+		{"relative path", `<img src="logo.png" width="10" height="10">`, nil},
+		{"remote URL", `<img src="https://example.com/logo.png">`, nil},
+		{"file URL", `<img src="file:///etc/hostname">`, nil},
+		{"invalid data URI", `<img src="data:image/png;base64">`, nil},
+		{"missing source", `<img>`, nil},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			element, err := NewHTML("<div>" + test.source + "</div>")
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			renderer := newTestRenderer(t)
+			element.Measure(NewWidth(300), renderer)
+
+			var images []*Image
+			for _, block := range element.flow.blocks {
+				if img, ok := block.(*Image); ok {
+					images = append(images, img)
+				}
+			}
+
+			if test.wantSize == nil {
+				if len(images) > 0 {
+					t.Fatalf("expected the image to be skipped, got %v", images[0].Source)
+				}
+			} else {
+				if len(images) != 1 {
+					t.Fatalf("expected one image, got %d", len(images))
+				}
+				if images[0].Size != *test.wantSize {
+					t.Fatalf("size = %v, want %v", images[0].Size, *test.wantSize)
+				}
+			}
+
+			if err := element.Render(renderer); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
+func TestHTMLRenderWithoutMeasure(t *testing.T) {
+	tests := []struct {
+		name   string
+		source string
+	}{
+		{"paragraph", "<p>Hello <b>world</b></p>"},
+		{"list", "<ul><li>one</li><li>two</li></ul>"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			element := &HTML{Source: test.source}
+			if err := element.Render(newTestRenderer(t)); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,209 @@
+package grpt
+
+import (
+	"embed"
+	"fmt"
+	"path"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+//go:embed assets/hyphenation
+var hyphenationDictionaries embed.FS
+
+const (
+	HyphenationEnglishUS    = "en-US"
+	HyphenationPortugueseBR = "pt-BR"
+)
+
+var (
+	standardHyphenationDictionaries = map[string]HyphenationDictionary{
+		HyphenationEnglishUS: {
+			Patterns:   "hyph-en-us.pat.txt",
+			Exceptions: "hyph-en-us.hyp.txt",
+			LeftMin:    2,
+			RightMin:   3,
+		},
+		HyphenationPortugueseBR: {
+			Patterns: "hyph-pt.pat.txt",
+			LeftMin:  2,
+			RightMin: 3,
+		},
+	}
+
+	hyphenatorsMutex sync.Mutex
+	hyphenators      = map[string]Hyphenator{}
+)
+
+type Hyphenator interface {
+	Hyphenate(word string) []int
+}
+
+type HyphenationDictionary struct {
+	Patterns   string
+	Exceptions string
+	LeftMin    int
+	RightMin   int
+}
+
+func RegisterHyphenator(language string, hyphenator Hyphenator) {
+	hyphenatorsMutex.Lock()
+	defer hyphenatorsMutex.Unlock()
+
+	hyphenators[strings.ToLower(language)] = hyphenator
+}
+
+func GetHyphenator(language string) (Hyphenator, error) {
+	hyphenatorsMutex.Lock()
+	defer hyphenatorsMutex.Unlock()
+
+	key := strings.ToLower(language)
+	if hyphenator, ok := hyphenators[key]; ok {
+		return hyphenator, nil
+	}
+
+	for name, dictionary := range standardHyphenationDictionaries {
+		if strings.ToLower(name) != key {
+			continue
+		}
+
+		hyphenator, err := loadStandardHyphenator(dictionary)
+		if err != nil {
+			return nil, err
+		}
+
+		hyphenators[key] = hyphenator
+		return hyphenator, nil
+	}
+
+	return nil, ErrHyphenatorNotFound.Wrap(
+		fmt.Errorf("no hyphenation dictionary for language '%s'", language),
+	)
+}
+
+func loadStandardHyphenator(
+	dictionary HyphenationDictionary,
+) (*PatternHyphenator, error) {
+	patterns, err := hyphenationDictionaries.ReadFile(
+		path.Join("assets/hyphenation", dictionary.Patterns),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	var exceptions []byte
+	if len(dictionary.Exceptions) > 0 {
+		exceptions, err = hyphenationDictionaries.ReadFile(
+			path.Join("assets/hyphenation", dictionary.Exceptions),
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	hyphenator := NewPatternHyphenator(string(patterns), string(exceptions))
+	hyphenator.LeftMin = dictionary.LeftMin
+	hyphenator.RightMin = dictionary.RightMin
+
+	return hyphenator, nil
+}
+
+type PatternHyphenator struct {
+	LeftMin  int
+	RightMin int
+
+	patterns         map[string][]int
+	exceptions       map[string][]int
+	maxPatternLength int
+}
+
+func NewPatternHyphenator(patterns string, exceptions string) *PatternHyphenator {
+	hyphenator := &PatternHyphenator{
+		LeftMin:    2,
+		RightMin:   2,
+		patterns:   map[string][]int{},
+		exceptions: map[string][]int{},
+	}
+
+	for _, pattern := range strings.Fields(patterns) {
+		hyphenator.addPattern(pattern)
+	}
+
+	for _, exception := range strings.Fields(exceptions) {
+		hyphenator.addException(exception)
+	}
+
+	return hyphenator
+}
+
+func (h *PatternHyphenator) addPattern(pattern string) {
+	var letters []rune
+	values := []int{0}
+	for _, char := range pattern {
+		if char >= '0' && char <= '9' {
+			values[len(values)-1] = int(char - '0')
+			continue
+		}
+
+		letters = append(letters, unicode.ToLower(char))
+		values = append(values, 0)
+	}
+
+	h.patterns[string(letters)] = values
+	h.maxPatternLength = max(h.maxPatternLength, len(letters))
+}
+
+func (h *PatternHyphenator) addException(exception string) {
+	var letters []rune
+	var points []int
+	for _, char := range exception {
+		if char == '-' {
+			points = append(points, len(letters))
+			continue
+		}
+		letters = append(letters, unicode.ToLower(char))
+	}
+
+	h.exceptions[string(letters)] = points
+}
+
+func (h *PatternHyphenator) Hyphenate(word string) []int {
+	letters := []rune(strings.ToLower(word))
+	if len(letters) < h.LeftMin+h.RightMin {
+		return nil
+	}
+
+	if points, ok := h.exceptions[string(letters)]; ok {
+		return points
+	}
+
+	padded := make([]rune, 0, len(letters)+2)
+	padded = append(padded, '.')
+	padded = append(padded, letters...)
+	padded = append(padded, '.')
+
+	values := make([]int, len(padded)+1)
+	for start := range padded {
+		end := min(len(padded), start+h.maxPatternLength)
+		for stop := start + 1; stop <= end; stop++ {
+			pattern, ok := h.patterns[string(padded[start:stop])]
+			if !ok {
+				continue
+			}
+
+			for index, value := range pattern {
+				values[start+index] = max(values[start+index], value)
+			}
+		}
+	}
+
+	var points []int
+	for index := max(h.LeftMin, 1); index <= len(letters)-h.RightMin; index++ {
+		if values[index+1]%2 == 1 {
+			points = append(points, index)
+		}
+	}
+
+	return points
+}
@@ -0,0 +1,48 @@
+package grpt
+
+import (
+	"strings"
+	"testing"
+)
+
+func hyphenatedWord(hyphenator Hyphenator, word string) string {
+	runes := []rune(word)
+	var builder strings.Builder
+	last := 0
+	for _, point := range hyphenator.Hyphenate(word) {
+		builder.WriteString(string(runes[last:point]))
+		builder.WriteString("-")
+		last = point
+	}
+	builder.WriteString(string(runes[last:]))
+	return builder.String()
+}
+
+func TestStandardHyphenators(t *testing.T) {
+	tests := []struct {
+		language string
+		word     string
+		want     string
+	}{
+		{HyphenationEnglishUS, "hyphenation", "hy-phen-a-tion"},
+		{HyphenationEnglishUS, "typography", "ty-pog-ra-phy"},
+		{HyphenationEnglishUS, "academies", "acad-e-mies"},
+		{HyphenationEnglishUS, "cat", "cat"},
+		{HyphenationPortugueseBR, "computador", "com-pu-ta-dor"},
+		{HyphenationPortugueseBR, "hardware", "hardware"},
+		{HyphenationPortugueseBR, "paralelepípedo", "pa-ra-le-le-pí-pedo"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.language+"/"+test.word, func(t *testing.T) {
+			hyphenator, err := GetHyphenator(test.language)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got := hyphenatedWord(hyphenator, test.word); got != test.want {
+				t.Errorf("%s = %q, want %q", test.word, got, test.want)
+			}
+		})
+	}
+}
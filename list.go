@@ -0,0 +1,358 @@
+package grpt
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+)
+
+type ListMarker int
+
+const (
+	ListMarkerBullet ListMarker = iota
+	ListMarkerDecimal
+	ListMarkerLowerAlpha
+	ListMarkerUpperAlpha
+	ListMarkerLowerRoman
+	ListMarkerUpperRoman
+	ListMarkerNone
+)
+
+const (
+	defaultListBullet    string  = "•"
+	defaultListMarkerGap float64 = 6
+)
+
+type ListItem struct {
+	Content  Element
+	Children *List
+}
+
+func NewListItem(content Element, children ...ListItem) ListItem {
+	item := ListItem{Content: content}
+	if len(children) > 0 {
+		item.Children = NewList(ListMarkerBullet, children...)
+	}
+	return item
+}
+
+type List struct {
+	Size        Size
+	Marker      ListMarker
+	Bullet      string
+	Start       int
+	Indent      float64
+	Spacing     float64
+	MarkerStyle TextStyle
+	Items       []ListItem
+
+	continued              bool
+	markers                []*Text
+	heights                []float64
+	indent                 float64
+	wasMeasuredAtLeastOnce bool
+	originalSize           Size
+}
+
+func NewList(marker ListMarker, items ...ListItem) *List {
+	return &List{Marker: marker, Items: items}
+}
+
+func (l List) GetSize() Size {
+	return l.Size
+}
+
+func (l *List) Measure(boundries Size, renderer *DocumentRenderer) {
+	if l.wasMeasuredAtLeastOnce {
+		l.Size = l.originalSize
+	} else {
+		l.originalSize = l.Size
+	}
+	l.wasMeasuredAtLeastOnce = true
+
+	if l.Size.Width == 0 || l.Size.Width == MaxSize {
+		l.Size.Width = boundries.Width
+	}
+
+	l.markers = make([]*Text, len(l.Items))
+	l.indent = l.Indent
+	for index := range l.Items {
+		if index == 0 && l.continued {
+			continue
+		}
+
+		marker := l.markerText(index)
+		if len(marker) == 0 {
+			continue
+		}
+
+		l.markers[index] = &Text{
+			Value:          marker,
+			SkipFormatting: true,
+			Style:          l.MarkerStyle,
+		}
+		l.markers[index].Measure(NewSize(0, 0), renderer)
+
+		if l.Indent == 0 {
+			markerWidth := l.markers[index].GetSize().Width
+			l.indent = max(l.indent, markerWidth+defaultListMarkerGap)
+		}
+	}
+
+	contentWidth := max(l.Size.Width-l.indent, 0)
+	l.heights = make([]float64, len(l.Items))
+	for index, item := range l.Items {
+		var height float64
+		if item.Content != nil {
+			item.Content.Measure(NewWidth(contentWidth), renderer)
+			height = item.Content.GetSize().Height
+		}
+
+		if item.Children != nil {
+			item.Children.Measure(NewWidth(contentWidth), renderer)
+			height += item.Children.GetSize().Height
+		}
+
+		if l.markers[index] != nil {
+			height = max(height, l.markers[index].GetSize().Height)
+		}
+
+		l.heights[index] = height
+	}
+
+	if l.Size.Height == 0 || l.Size.Height == MaxSize {
+		l.Size.Height = l.totalHeight(l.heights)
+	}
+}
+
+func (l *List) Render(renderer *DocumentRenderer) error {
+	defer renderer.SetOffset(renderer.GetCurrentOffset())
+
+	position := renderer.GetCurrentOffset()
+	for index, item := range l.Items {
+		if marker := l.markers[index]; marker != nil {
+			renderer.SetOffset(position)
+			if content, ok := item.Content.(BaselineElement); ok {
+				renderer.AddY(content.Baseline(renderer) - marker.Baseline(renderer))
+			}
+
+			if err := marker.Render(renderer); err != nil {
+				return err
+			}
+		}
+
+		renderer.SetOffset(NewOffset(position.X+l.indent, position.Y))
+		if item.Content != nil {
+			if err := item.Content.Render(renderer); err != nil {
+				return err
+			}
+			renderer.AddY(item.Content.GetSize().Height)
+		}
+
+		if item.Children != nil {
+			if err := item.Children.Render(renderer); err != nil {
+				return err
+			}
+		}
+
+		position.Y += l.heights[index] + l.Spacing
+	}
+
+	return nil
+}
+
+func (l *List) Split(
+	height float64,
+	renderer *DocumentRenderer,
+) (Element, Element, bool) {
+	if l.Size.Height <= height {
+		return l, nil, true
+	}
+
+	var used float64
+	for index, item := range l.Items {
+		if index > 0 {
+			used += l.Spacing
+		}
+
+		if used+l.heights[index] <= height {
+			used += l.heights[index]
+			continue
+		}
+
+		head := slices.Clone(l.Items[:index])
+		tail := slices.Clone(l.Items[index+1:])
+
+		itemHead, itemTail := l.splitItem(item, height-used, renderer)
+		if itemHead != nil {
+			head = append(head, *itemHead)
+		}
+
+		continued := itemTail != nil
+		if itemTail != nil {
+			tail = slices.Insert(tail, 0, *itemTail)
+		} else if itemHead == nil {
+			tail = slices.Insert(tail, 0, item)
+		}
+
+		if len(head) == 0 {
+			return nil, nil, false
+		}
+
+		if len(tail) == 0 {
+			return l, nil, true
+		}
+
+		headList := l.fragment(head, l.Start, l.continued)
+		headList.Measure(NewWidth(l.Size.Width), renderer)
+
+		start := l.number(len(head))
+		if continued {
+			start = l.number(len(head) - 1)
+		}
+
+		tailList := l.fragment(tail, start, continued)
+		tailList.Measure(NewWidth(l.Size.Width), renderer)
+
+		return headList, tailList, true
+	}
+
+	return l, nil, true
+}
+
+func (l *List) splitItem(
+	item ListItem,
+	height float64,
+	renderer *DocumentRenderer,
+) (*ListItem, *ListItem) {
+	var contentHeight float64
+	if item.Content != nil {
+		contentHeight = item.Content.GetSize().Height
+	}
+
+	if contentHeight > height {
+		contentHead, contentTail := splitElement(item.Content, height, renderer)
+		if contentHead == nil {
+			return nil, nil
+		}
+
+		if contentTail == nil {
+			return &item, nil
+		}
+
+		return &ListItem{Content: contentHead},
+			&ListItem{Content: contentTail, Children: item.Children}
+	}
+
+	if item.Children == nil {
+		return &item, nil
+	}
+
+	childrenHead, childrenTail := splitElement(
+		item.Children,
+		height-contentHeight,
+		renderer,
+	)
+
+	if childrenHead == nil {
+		if item.Content == nil {
+			return nil, nil
+		}
+		return &ListItem{Content: item.Content},
+			&ListItem{Children: item.Children}
+	}
+
+	if childrenTail == nil {
+		return &item, nil
+	}
+
+	return &ListItem{Content: item.Content, Children: childrenHead.(*List)},
+		&ListItem{Children: childrenTail.(*List)}
+}
+
+func (l *List) fragment(items []ListItem, start int, continued bool) *List {
+	return &List{
+		Marker:      l.Marker,
+		Bullet:      l.Bullet,
+		Start:       start,
+		Indent:      l.indent,
+		Spacing:     l.Spacing,
+		MarkerStyle: l.MarkerStyle,
+		Items:       items,
+		continued:   continued,
+	}
+}
+
+func (l *List) totalHeight(heights []float64) float64 {
+	var total float64
+	for index, height := range heights {
+		if index > 0 {
+			total += l.Spacing
+		}
+		total += height
+	}
+	return total
+}
+
+func (l *List) number(index int) int {
+	start := l.Start
+	if start == 0 {
+		start = 1
+	}
+	return start + index
+}
+
+func (l *List) markerText(index int) string {
+	number := l.number(index)
+	switch l.Marker {
+	case ListMarkerNone:
+		return ""
+	case ListMarkerDecimal:
+		return strconv.Itoa(number) + "."
+	case ListMarkerLowerAlpha:
+		return strings.ToLower(alphaNumeral(number)) + "."
+	case ListMarkerUpperAlpha:
+		return alphaNumeral(number) + "."
+	case ListMarkerLowerRoman:
+		return strings.ToLower(romanNumeral(number)) + "."
+	case ListMarkerUpperRoman:
+		return romanNumeral(number) + "."
+	default:
+		if len(l.Bullet) > 0 {
+			return l.Bullet
+		}
+		return defaultListBullet
+	}
+}
+
+func alphaNumeral(number int) string {
+	if number <= 0 {
+		return strconv.Itoa(number)
+	}
+
+	var letters []byte
+	for number > 0 {
+		number--
+		letters = append([]byte{byte('A' + number%26)}, letters...)
+		number /= 26
+	}
+	return string(letters)
+}
+
+func romanNumeral(number int) string {
+	if number <= 0 || number >= 4000 {
+		return strconv.Itoa(number)
+	}
+
+	values := []int{1000, 900, 500, 400, 100, 90, 50, 40, 10, 9, 5, 4, 1}
+	symbols := []string{"M", "CM", "D", "CD", "C", "XC", "L", "XL", "X", "IX", "V", "IV", "I"}
+
+	var numeral strings.Builder
+	for index, value := range values {
+		for number >= value {
+			numeral.WriteString(symbols[index])
+			number -= value
+		}
+	}
+	return numeral.String()
+}
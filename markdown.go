@@ -0,0 +1,551 @@
+package grpt
+
+import (
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+const defaultMarkdownSpacing float64 = 6
+
+const (
+	MarkdownParagraph   = ThemeParagraph
+	MarkdownHeading1    = ThemeHeading1
+	MarkdownHeading2    = ThemeHeading2
+	MarkdownHeading3    = ThemeHeading3
+	MarkdownHeading4    = ThemeHeading4
+	MarkdownHeading5    = ThemeHeading5
+	MarkdownHeading6    = ThemeHeading6
+	MarkdownCode        = ThemeCode
+	MarkdownCodeBlock   = ThemeCodeBlock
+	MarkdownLink        = ThemeLink
+	MarkdownListItem    = ThemeListItem
+	MarkdownTableHeader = ThemeTableHeader
+	MarkdownTableCell   = ThemeTableCell
+)
+
+type MarkdownTheme = TextTheme
+
+func DefaultMarkdownTheme() MarkdownTheme {
+	return DefaultTextTheme()
+}
+
+var (
+	markdownHeadingPattern   = regexp.MustCompile(`^(#{1,6})\s+(.*?)\s*#*$`)
+	markdownListItemPattern  = regexp.MustCompile(`^(\s*)([-*+]|\d+[.)])\s+(.*)$`)
+	markdownTableSeparator   = regexp.MustCompile(`^\|?\s*:?-+:?\s*(\|\s*:?-+:?\s*)*\|?$`)
+	markdownLinkPattern      = regexp.MustCompile(`^\[([^\]]*)\]\(([^)\s]*)\)`)
+	markdownOrderedListStart = regexp.MustCompile(`^\d+`)
+)
+
+type Markdown struct {
+	Size    Size
+	Source  string
+	Theme   TextTheme
+	Spacing float64
+
+	flow                   *blockFlow
+	builtWidth             float64
+	wasMeasuredAtLeastOnce bool
+	originalSize           Size
+}
+
+func NewMarkdown(source string) *Markdown {
+	return &Markdown{Source: source}
+}
+
+func (m Markdown) GetSize() Size {
+	return m.Size
+}
+
+func (m *Markdown) Measure(boundries Size, renderer *DocumentRenderer) {
+	if m.wasMeasuredAtLeastOnce {
+		m.Size = m.originalSize
+	} else {
+		m.originalSize = m.Size
+	}
+
+	if m.Size.Width == 0 || m.Size.Width == MaxSize {
+		m.Size.Width = boundries.Width
+	}
+
+	m.wasMeasuredAtLeastOnce = true
+
+	if m.flow == nil || len(m.Source) > 0 && m.builtWidth != m.Size.Width {
+		m.build(renderer)
+	}
+
+	height := m.flow.measure(m.Size.Width, renderer)
+	if m.Size.Height == 0 || m.Size.Height == MaxSize {
+		m.Size.Height = height
+	}
+}
+
+func (m *Markdown) Render(renderer *DocumentRenderer) error {
+	if m.flow == nil {
+		m.Measure(GetAvailableSpace(renderer.Context()), renderer)
+	}
+	return m.flow.render(renderer)
+}
+
+func (m *Markdown) Split(
+	height float64,
+	renderer *DocumentRenderer,
+) (Element, Element, bool) {
+	if m.flow == nil {
+		m.Measure(GetAvailableSpace(renderer.Context()), renderer)
+	}
+
+	if m.Size.Height <= height {
+		return m, nil, true
+	}
+
+	head, tail, ok := m.flow.split(height, renderer)
+	if !ok || tail == nil {
+		return m, nil, ok
+	}
+
+	headMarkdown := m.fragment(head)
+	headMarkdown.Measure(NewWidth(m.Size.Width), renderer)
+
+	tailMarkdown := m.fragment(tail)
+	tailMarkdown.Measure(NewWidth(m.Size.Width), renderer)
+
+	return headMarkdown, tailMarkdown, true
+}
+
+func (m *Markdown) fragment(flow *blockFlow) *Markdown {
+	return &Markdown{
+		Theme:   m.Theme,
+		Spacing: m.Spacing,
+		flow:    flow,
+	}
+}
+
+func (m *Markdown) spacing() float64 {
+	if m.Spacing == 0 {
+		return defaultMarkdownSpacing
+	}
+	return m.Spacing
+}
+
+func (m *Markdown) build(renderer *DocumentRenderer) {
+	m.flow = &blockFlow{}
+	m.builtWidth = m.Size.Width
+
+	source := strings.ReplaceAll(m.Source, "\r\n", "\n")
+	lines := strings.Split(source, "\n")
+	for index := 0; index < len(lines); {
+		trimmed := strings.TrimSpace(lines[index])
+
+		switch {
+		case len(trimmed) == 0:
+			index++
+
+		case strings.HasPrefix(trimmed, "```"):
+			index = m.buildCodeBlock(lines, index)
+
+		case markdownHeadingPattern.MatchString(trimmed):
+			match := markdownHeadingPattern.FindStringSubmatch(trimmed)
+			key := "h" + strconv.Itoa(len(match[1]))
+			m.flow.add(m.richText(match[2], m.Theme.Style(key)), m.spacing())
+			index++
+
+		case isMarkdownTableStart(lines, index):
+			index = m.buildTable(lines, index, renderer)
+
+		case markdownListItemPattern.MatchString(lines[index]):
+			index = m.buildList(lines, index)
+
+		default:
+			index = m.buildParagraph(lines, index)
+		}
+	}
+}
+
+func (m *Markdown) buildParagraph(lines []string, index int) int {
+	var paragraph []string
+	for ; index < len(lines); index++ {
+		trimmed := strings.TrimSpace(lines[index])
+		if len(trimmed) == 0 ||
+			strings.HasPrefix(trimmed, "```") ||
+			markdownHeadingPattern.MatchString(trimmed) ||
+			markdownListItemPattern.MatchString(lines[index]) ||
+			isMarkdownTableStart(lines, index) {
+			break
+		}
+		paragraph = append(paragraph, trimmed)
+	}
+
+	text := strings.Join(paragraph, " ")
+	m.flow.add(m.richText(text, m.Theme.Style(ThemeParagraph)), m.spacing())
+	return index
+}
+
+func (m *Markdown) buildCodeBlock(lines []string, index int) int {
+	var code []string
+	for index++; index < len(lines); index++ {
+		if strings.HasPrefix(strings.TrimSpace(lines[index]), "```") {
+			index++
+			break
+		}
+		code = append(code, lines[index])
+	}
+
+	style := m.Theme.Style(ThemeCodeBlock)
+	style.Multiline = true
+	m.flow.add(&Text{
+		Value:          strings.Join(code, "\n"),
+		SkipFormatting: true,
+		Style:          style,
+	}, m.spacing())
+
+	return index
+}
+
+type markdownListEntry struct {
+	indent  int
+	ordered bool
+	number  int
+	text    string
+}
+
+func (m *Markdown) buildList(lines []string, index int) int {
+	var entries []markdownListEntry
+	for ; index < len(lines); index++ {
+		line := strings.ReplaceAll(lines[index], "\t", "    ")
+		if len(strings.TrimSpace(line)) == 0 {
+			next := index + 1
+			if next < len(lines) && markdownListItemPattern.MatchString(lines[next]) {
+				continue
+			}
+			break
+		}
+
+		match := markdownListItemPattern.FindStringSubmatch(line)
+		if match == nil {
+			isContinuation := len(entries) > 0 &&
+				unicode.IsSpace(rune(line[0]))
+			if !isContinuation {
+				break
+			}
+
+			last := &entries[len(entries)-1]
+			last.text += " " + strings.TrimSpace(line)
+			continue
+		}
+
+		entry := markdownListEntry{
+			indent: len(match[1]),
+			text:   match[3],
+		}
+
+		if number := markdownOrderedListStart.FindString(match[2]); len(number) > 0 {
+			entry.ordered = true
+			entry.number, _ = strconv.Atoi(number)
+		}
+
+		entries = append(entries, entry)
+	}
+
+	m.flow.add(m.list(entries), m.spacing())
+	return index
+}
+
+func (m *Markdown) list(entries []markdownListEntry) *List {
+	list := &List{Marker: ListMarkerBullet, Spacing: 2}
+	if entries[0].ordered {
+		list.Marker = ListMarkerDecimal
+		list.Start = entries[0].number
+	}
+
+	style := m.Theme.Style(ThemeListItem)
+	list.MarkerStyle = style
+
+	base := entries[0].indent
+	for index := 0; index < len(entries); {
+		next := index + 1
+		for next < len(entries) && entries[next].indent > base {
+			next++
+		}
+
+		item := ListItem{Content: m.richText(entries[index].text, style)}
+		if next > index+1 {
+			item.Children = m.list(entries[index+1 : next])
+		}
+
+		list.Items = append(list.Items, item)
+		index = next
+	}
+
+	return list
+}
+
+func isMarkdownTableStart(lines []string, index int) bool {
+	if index+1 >= len(lines) {
+		return false
+	}
+
+	header := strings.TrimSpace(lines[index])
+	separator := strings.TrimSpace(lines[index+1])
+	return strings.HasPrefix(header, "|") &&
+		strings.Contains(separator, "-") &&
+		markdownTableSeparator.MatchString(separator)
+}
+
+func (m *Markdown) buildTable(
+	lines []string,
+	index int,
+	renderer *DocumentRenderer,
+) int {
+	header := splitMarkdownTableRow(lines[index])
+	separators := splitMarkdownTableRow(lines[index+1])
+
+	alignments := make([]Alignment, len(header))
+	for column := range alignments {
+		if column >= len(separators) {
+			break
+		}
+
+		separator := separators[column]
+		left := strings.HasPrefix(separator, ":")
+		right := strings.HasSuffix(separator, ":")
+		switch {
+		case left && right:
+			alignments[column] = HorizontalCenterAlignment
+		case right:
+			alignments[column] = RightAlignment
+		}
+	}
+
+	spacing := m.spacing()
+	m.flow.add(
+		m.tableRow(header, alignments, m.Theme.Style(ThemeTableHeader), renderer),
+		spacing,
+	)
+
+	for index += 2; index < len(lines); index++ {
+		trimmed := strings.TrimSpace(lines[index])
+		if !strings.HasPrefix(trimmed, "|") {
+			break
+		}
+
+		cells := splitMarkdownTableRow(trimmed)
+		m.flow.add(
+			m.tableRow(cells, alignments, m.Theme.Style(ThemeTableCell), renderer),
+			0,
+		)
+	}
+
+	return index
+}
+
+func (m *Markdown) tableRow(
+	cells []string,
+	alignments []Alignment,
+	style TextStyle,
+	renderer *DocumentRenderer,
+) *Row {
+	texts := make([]*RichText, len(alignments))
+	for column := range alignments {
+		var cell string
+		if column < len(cells) {
+			cell = cells[column]
+		}
+
+		cellStyle := style
+		cellStyle.Alignment = cellStyle.Alignment | alignments[column]
+		texts[column] = m.richText(cell, cellStyle)
+	}
+
+	return newTableRow(m.Size.Width, texts, renderer)
+}
+
+func splitMarkdownTableRow(line string) []string {
+	line = strings.TrimSpace(line)
+	line = strings.TrimPrefix(line, "|")
+	line = strings.TrimSuffix(line, "|")
+
+	var cells []string
+	var cell strings.Builder
+	escaped := false
+	for _, char := range line {
+		switch {
+		case escaped:
+			cell.WriteRune(char)
+			escaped = false
+		case char == '\\':
+			escaped = true
+		case char == '|':
+			cells = append(cells, strings.TrimSpace(cell.String()))
+			cell.Reset()
+		default:
+			cell.WriteRune(char)
+		}
+	}
+
+	return append(cells, strings.TrimSpace(cell.String()))
+}
+
+func (m *Markdown) richText(text string, style TextStyle) *RichText {
+	style.Multiline = true
+	style.WordWrap = true
+
+	return &RichText{
+		Style: style,
+		Spans: m.inlineSpans(text, style),
+	}
+}
+
+type markdownInlineState struct {
+	bold          bool
+	italic        bool
+	strikethrough bool
+}
+
+func (m *Markdown) inlineSpans(text string, style TextStyle) []TextSpan {
+	var spans []TextSpan
+	var buffer strings.Builder
+	var state markdownInlineState
+
+	flush := func() {
+		if buffer.Len() == 0 {
+			return
+		}
+
+		spans = append(spans, TextSpan{
+			Text:          buffer.String(),
+			Font:          markdownFont(style.Font, state),
+			Color:         style.Color,
+			Strikethrough: state.strikethrough,
+		})
+		buffer.Reset()
+	}
+
+	runes := []rune(text)
+	for index := 0; index < len(runes); index++ {
+		char := runes[index]
+		rest := string(runes[index:])
+
+		switch {
+		case char == '\\' && index+1 < len(runes):
+			index++
+			buffer.WriteRune(runes[index])
+
+		case char == '`':
+			after := runes[index+1:]
+			end := slices.Index(after, '`')
+			if end < 0 {
+				buffer.WriteRune(char)
+				continue
+			}
+
+			flush()
+			codeStyle := m.Theme.Style(ThemeCode)
+			font := style.Font
+			if codeStyle.Font != nil {
+				merged := codeStyle.Font.Merge(derefFont(style.Font))
+				font = &merged
+			}
+
+			color := style.Color
+			if codeStyle.Color != nil {
+				color = codeStyle.Color
+			}
+
+			spans = append(spans, TextSpan{
+				Text:  string(after[:end]),
+				Font:  markdownFont(font, state),
+				Color: color,
+			})
+			index += end + 1
+
+		case char == '[' && markdownLinkPattern.MatchString(rest):
+			match := markdownLinkPattern.FindStringSubmatch(rest)
+			flush()
+
+			color := style.Color
+			if linkStyle := m.Theme.Style(ThemeLink); linkStyle.Color != nil {
+				color = linkStyle.Color
+			}
+
+			spans = append(spans, TextSpan{
+				Text:          match[1],
+				Font:          markdownFont(style.Font, state),
+				Color:         color,
+				Underline:     true,
+				Strikethrough: state.strikethrough,
+				Link:          match[2],
+			})
+			index += len([]rune(match[0])) - 1
+
+		case strings.HasPrefix(rest, "**") || strings.HasPrefix(rest, "__"):
+			marker := rest[:2]
+			if !state.bold && !strings.Contains(rest[2:], marker) {
+				buffer.WriteString(marker)
+			} else {
+				flush()
+				state.bold = !state.bold
+			}
+			index++
+
+		case strings.HasPrefix(rest, "~~"):
+			if !state.strikethrough && !strings.Contains(rest[2:], "~~") {
+				buffer.WriteString("~~")
+			} else {
+				flush()
+				state.strikethrough = !state.strikethrough
+			}
+			index++
+
+		case char == '*' || char == '_':
+			isWordChar := func(position int) bool {
+				return position >= 0 && position < len(runes) &&
+					(unicode.IsLetter(runes[position]) || unicode.IsDigit(runes[position]))
+			}
+
+			intraword := char == '_' && isWordChar(index-1) && isWordChar(index+1)
+			unmatched := !state.italic && !strings.ContainsRune(rest[1:], char)
+			if intraword || unmatched {
+				buffer.WriteRune(char)
+				continue
+			}
+
+			flush()
+			state.italic = !state.italic
+
+		default:
+			buffer.WriteRune(char)
+		}
+	}
+
+	flush()
+	return spans
+}
+
+func derefFont(font *Font) Font {
+	if font == nil {
+		return Font{}
+	}
+	return *font
+}
+
+func markdownFont(font *Font, state markdownInlineState) *Font {
+	if font == nil && !state.bold && !state.italic {
+		return nil
+	}
+
+	result := derefFont(font)
+
+	var fontStyle FontStyle
+	if result.Style != nil {
+		fontStyle = *result.Style
+	}
+	fontStyle.Bold = fontStyle.Bold || state.bold
+	fontStyle.Italic = fontStyle.Italic || state.italic
+	result.Style = &fontStyle
+
+	return &result
+}
@@ -0,0 +1,47 @@
+package grpt
+
+import "testing"
+
+func TestMarkdownRenderWithoutMeasure(t *testing.T) {
+	tests := []struct {
+		name   string
+		source string
+	}{
+		{"empty", ""},
+		{"heading and paragraph", "# Title\n\nSome *emphasis* and **strong** text."},
+		{"list", "- one\n- two\n  - nested"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			markdown := &Markdown{Source: test.source}
+			if err := markdown.Render(newTestRenderer(t)); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
+func TestMarkdownThemeAliases(t *testing.T) {
+	tests := []struct {
+		legacy string
+		theme  string
+	}{
+		{MarkdownParagraph, ThemeParagraph},
+		{MarkdownHeading1, ThemeHeading1},
+		{MarkdownCode, ThemeCode},
+		{MarkdownCodeBlock, ThemeCodeBlock},
+		{MarkdownTableCell, ThemeTableCell},
+	}
+
+	var theme MarkdownTheme = DefaultMarkdownTheme()
+	markdown := &Markdown{Theme: theme}
+	for _, test := range tests {
+		if test.legacy != test.theme {
+			t.Errorf("%q != %q", test.legacy, test.theme)
+		}
+		if _, ok := markdown.Theme[test.legacy]; !ok && test.legacy != MarkdownParagraph {
+			t.Errorf("default theme has no %q style", test.legacy)
+		}
+	}
+}
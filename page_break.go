@@ -0,0 +1,30 @@
+package grpt
+
+type PageBreak struct {
+	MinSpace float64
+}
+
+func NewPageBreak() *PageBreak {
+	return &PageBreak{}
+}
+
+func NewConditionalPageBreak(minSpace float64) *PageBreak {
+	return &PageBreak{MinSpace: minSpace}
+}
+
+func (p PageBreak) GetSize() Size {
+	return NewSize(0, 0)
+}
+
+func (p *PageBreak) Measure(_ Size, _ *DocumentRenderer) {}
+
+func (p *PageBreak) Render(renderer *DocumentRenderer) error {
+	if p.MinSpace <= 0 || !renderer.FitsCurrentPage(p.MinSpace) {
+		renderer.AddPage()
+	}
+	return nil
+}
+
+func (p *PageBreak) ShouldBreak(availableSpace float64) bool {
+	return p.MinSpace <= 0 || availableSpace < p.MinSpace
+}
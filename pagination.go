@@ -0,0 +1,56 @@
+package grpt
+
+type Pagination struct {
+	KeepTogether bool
+	KeepWithNext bool
+	Child        Element
+}
+
+func NewKeepTogether(child Element) *Pagination {
+	return &Pagination{KeepTogether: true, Child: child}
+}
+
+func NewKeepWithNext(child Element) *Pagination {
+	return &Pagination{KeepWithNext: true, Child: child}
+}
+
+func (p Pagination) GetSize() Size {
+	return p.Child.GetSize()
+}
+
+func (p *Pagination) Measure(boundries Size, renderer *DocumentRenderer) {
+	p.Child.Measure(boundries, renderer)
+}
+
+func (p *Pagination) Render(renderer *DocumentRenderer) error {
+	return p.Child.Render(renderer)
+}
+
+func (p *Pagination) Split(
+	height float64,
+	renderer *DocumentRenderer,
+) (Element, Element, bool) {
+	if p.GetSize().Height <= height {
+		return p, nil, true
+	}
+
+	if p.KeepTogether {
+		return nil, nil, false
+	}
+
+	head, tail := splitElement(p.Child, height, renderer)
+	if head == nil {
+		return nil, nil, false
+	}
+
+	if tail == nil {
+		return p, nil, true
+	}
+
+	return head, &Pagination{KeepWithNext: p.KeepWithNext, Child: tail}, true
+}
+
+func keepsWithNext(element Element) bool {
+	pagination, ok := element.(*Pagination)
+	return ok && pagination.KeepWithNext
+}
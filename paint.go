@@ -0,0 +1,41 @@
+package grpt
+
+type Paint interface {
+	fill(
+		renderer *DocumentRenderer,
+		subpaths []shapeSubpath,
+		opacity float64,
+	) error
+}
+
+func (c Color) fill(
+	renderer *DocumentRenderer,
+	subpaths []shapeSubpath,
+	opacity float64,
+) error {
+	return renderer.drawShape(subpaths, ShapeOptions{Fill: c, Opacity: opacity})
+}
+
+const defaultShadowOpacity = 0.25
+
+type BoxShadow struct {
+	Offset  Offset
+	Color   Color
+	Opacity float64
+	Spread  float64
+}
+
+func NewBoxShadow(offset Offset, color Color, opacity float64) *BoxShadow {
+	return &BoxShadow{
+		Offset:  offset,
+		Color:   color,
+		Opacity: opacity,
+	}
+}
+
+func (b BoxShadow) opacity() float64 {
+	if b.Opacity <= 0 {
+		return defaultShadowOpacity
+	}
+	return min(b.Opacity, 1)
+}
@@ -9,9 +9,10 @@ import (
 	"image/jpeg"
 	"image/png"
 	"io"
-	"math"
-	"path"
 	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
 
 	"github.com/signintech/gopdf"
 )
@@ -19,19 +20,43 @@ import (
 type rendererState struct {
 	StrokeWidth float64
 	StrokeColor Color
+	TextColor   Color
+	FillColor   Color
 	Font        Font
 	LineStyle   LineStyle
 }
 
+type activeFont struct {
+	Family    string
+	Style     int
+	Size      float64
+	Fallbacks []string
+	Strict    bool
+}
+
+type fontRun struct {
+	Text   string
+	Family string
+	Style  int
+}
+
 type RendererOptions struct {
-	PageSize Size
-	Padding  EdgeInsets
+	PageSize     Size
+	Padding      EdgeInsets
+	Font         Font
+	FontFamilies []FontFamily
 }
 
 type DocumentRenderer struct {
 	options      RendererOptions
 	engine       gopdf.GoPdf
 	currentState rendererState
+	fontMetrics  map[string]fontMetrics
+	fontFamilies map[string]FontFamily
+	activeFont   activeFont
+
+	shadings       map[string]int
+	shadingSources []*io.ReadSeeker
 
 	addingPageHooks []func(*DocumentRenderer)
 
@@ -58,8 +83,8 @@ func StartNewDocument(options RendererOptions) *DocumentRenderer {
 		PageSize: *options.PageSize.ToRect(),
 	})
 
-	renderer.AddMultiFontFamilies(standardFontFamilies...)
-	renderer.SetFont(standardFont)
+	defaultFont := options.Font.Merge(standardFont)
+	renderer.SetFont(defaultFont)
 
 	renderer.engine.SetMargins(
 		options.Padding.Left,
@@ -69,7 +94,7 @@ func StartNewDocument(options RendererOptions) *DocumentRenderer {
 	)
 	renderer.engine.AddPage()
 
-	renderer.currentState.Font = standardFont
+	renderer.currentState.Font = defaultFont
 
 	renderer.context = SetAvailableSpace(
 		ctx.Background(),
@@ -194,35 +219,35 @@ func (r *DocumentRenderer) AddToAxis(value float64, axis Axis) {
 }
 
 func (r *DocumentRenderer) addFont(family FontFamily, style int) error {
-	var fileName string
-	switch style {
-	case gopdf.Regular:
-		fileName = family.Regular
-	case gopdf.Italic:
-		fileName = family.Italic
-	case gopdf.Bold:
-		fileName = family.Bold
-	case gopdf.Bold | gopdf.Italic:
-		fileName = family.BoldItalic
-	default:
+	if len(family.styleFile(style)) == 0 {
 		panic("invalid font style")
 	}
 
 	ttfOption := gopdf.TtfOption{Style: style}
-	filePath := path.Join(family.Path, fileName)
+	data, metrics, err := sharedFonts.load(family, style)
+	if err != nil {
+		return err
+	}
 
-	var err error
-	if family.Source != nil {
-		file, _ := family.Source.Open(filePath)
-		err = r.engine.AddTTFFontByReaderWithOption(family.Name, file, ttfOption)
-	} else {
-		err = r.engine.AddTTFFontWithOption(family.Name, filePath, ttfOption)
+	err = r.engine.AddTTFFontDataWithOption(family.Name, data, ttfOption)
+	if err != nil {
+		return err
+	}
+
+	if r.fontMetrics == nil {
+		r.fontMetrics = map[string]fontMetrics{}
 	}
+	r.fontMetrics[fontKey(family.Name, style)] = metrics
 
-	return err
+	return nil
 }
 
 func (r *DocumentRenderer) AddFontFamily(family FontFamily) error {
+	if r.fontFamilies == nil {
+		r.fontFamilies = map[string]FontFamily{}
+	}
+	r.fontFamilies[family.Name] = family
+
 	if family.HasRegularStyle() {
 		err := r.addFont(family, gopdf.Regular)
 		if err != nil {
@@ -265,6 +290,52 @@ func (r *DocumentRenderer) AddMultiFontFamilies(families ...FontFamily) error {
 	return nil
 }
 
+func (r *DocumentRenderer) loadFontFamily(name string, style int) (string, error) {
+	family, ok := r.fontFamilies[name]
+	if !ok {
+		family, ok = r.lookupFontFamily(name)
+		if !ok {
+			return name, nil
+		}
+
+		if r.fontFamilies == nil {
+			r.fontFamilies = map[string]FontFamily{}
+		}
+		r.fontFamilies[family.Name] = family
+	}
+
+	style &^= gopdf.Underline
+	if len(family.styleFile(style)) == 0 {
+		style = gopdf.Regular
+	}
+
+	if _, ok := r.fontMetrics[fontKey(family.Name, style)]; ok {
+		return family.Name, nil
+	}
+
+	if len(family.styleFile(style)) == 0 {
+		return family.Name, nil
+	}
+
+	return family.Name, r.addFont(family, style)
+}
+
+func (r *DocumentRenderer) lookupFontFamily(name string) (FontFamily, bool) {
+	for _, family := range r.fontFamilies {
+		if strings.EqualFold(family.Name, name) {
+			return family, true
+		}
+	}
+
+	for _, family := range r.options.FontFamilies {
+		if strings.EqualFold(family.Name, name) {
+			return family, true
+		}
+	}
+
+	return LookupFontFamily(name)
+}
+
 func (r *DocumentRenderer) OnAddingPage(hooks ...func(*DocumentRenderer)) {
 	r.addingPageHooks = append(r.addingPageHooks, hooks...)
 }
@@ -277,6 +348,8 @@ func (r *DocumentRenderer) setFont(font Font, keepCurrentState bool) (Font, erro
 	var family string = r.currentState.Font.Family
 	var style int = r.currentState.Font.Style.Combine()
 	var size float64 = r.currentState.Font.Size
+	var fallbacks []string = r.currentState.Font.Fallbacks
+	var strict bool = r.currentState.Font.Strict
 
 	if len(font.Family) > 0 {
 		family = font.Family
@@ -290,12 +363,41 @@ func (r *DocumentRenderer) setFont(font Font, keepCurrentState bool) (Font, erro
 		style = font.Style.Combine()
 	}
 
-	err := r.engine.SetFontWithStyle(family, style, size)
+	if font.Fallbacks != nil {
+		fallbacks = font.Fallbacks
+	}
+
+	if font.Strict {
+		strict = true
+	}
+
+	family, err := r.loadFontFamily(family, style)
+	if err != nil {
+		return r.currentState.Font, err
+	}
+
+	fallbacks = slices.Clone(fallbacks)
+	for index, name := range fallbacks {
+		fallbacks[index], err = r.loadFontFamily(name, style)
+		if err != nil {
+			return r.currentState.Font, err
+		}
+	}
+
+	err = r.engine.SetFontWithStyle(family, style, size)
 
 	if err != nil {
 		return r.currentState.Font, nil
 	}
 
+	r.activeFont = activeFont{
+		Family:    family,
+		Style:     style,
+		Size:      size,
+		Fallbacks: fallbacks,
+		Strict:    strict,
+	}
+
 	lastFont := r.currentState.Font
 	if !keepCurrentState {
 		r.currentState.Font = font
@@ -333,8 +435,13 @@ func (r *DocumentRenderer) setFontStyle(
 	style FontStyle,
 	keepCurrentState bool,
 ) (*FontStyle, error) {
-	err := r.engine.SetFontWithStyle(
-		r.currentState.Font.Family,
+	_, err := r.loadFontFamily(r.activeFont.Family, style.Combine())
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.engine.SetFontWithStyle(
+		r.activeFont.Family,
 		style.Combine(),
 		r.currentState.Font.Size,
 	)
@@ -342,6 +449,7 @@ func (r *DocumentRenderer) setFontStyle(
 	if err != nil {
 		return nil, err
 	}
+	r.activeFont.Style = style.Combine()
 
 	lastStyle := r.currentState.Font.Style
 	if !keepCurrentState {
@@ -363,6 +471,7 @@ func (r *DocumentRenderer) setFontSize(
 	if err != nil {
 		return r.currentState.Font.Size, err
 	}
+	r.activeFont.Size = size
 
 	lastSize := r.currentState.Font.Size
 	if !keepCurrentState {
@@ -408,6 +517,42 @@ func (r *DocumentRenderer) setStrokeColor(
 	return lastColor
 }
 
+func (r *DocumentRenderer) SetTextColor(color Color) Color {
+	return r.setTextColor(color, false)
+}
+
+func (r *DocumentRenderer) setTextColor(
+	color Color,
+	keepCurrentState bool,
+) Color {
+	r.engine.SetTextColor(color.R, color.G, color.B)
+
+	lastColor := r.currentState.TextColor
+	if !keepCurrentState {
+		r.currentState.TextColor = color
+	}
+
+	return lastColor
+}
+
+func (r *DocumentRenderer) SetFillColor(color Color) Color {
+	return r.setFillColor(color, false)
+}
+
+func (r *DocumentRenderer) setFillColor(
+	color Color,
+	keepCurrentState bool,
+) Color {
+	r.engine.SetFillColor(color.R, color.G, color.B)
+
+	lastColor := r.currentState.FillColor
+	if !keepCurrentState {
+		r.currentState.FillColor = color
+	}
+
+	return lastColor
+}
+
 func (r *DocumentRenderer) SetLineStyle(style LineStyle) LineStyle {
 	return r.setLineStyle(style, false)
 }
@@ -458,7 +603,7 @@ func (r *DocumentRenderer) FitsIn(
 	offset := r.GetCurrentOffset()
 
 	limit := parentPosition.Y + parentSize.Height
-	return offset.Y+element.Height <= limit
+	return offset.Y+element.Height <= limit+sizeTolerance
 }
 
 func (r *DocumentRenderer) BodyHeight() float64 {
@@ -602,238 +747,1379 @@ func (r *DocumentRenderer) DrawText(
 		return r.engine.Cell(nil, text)
 	}
 
-	if style.Font != nil {
-		r.setFont(*style.Font, true)
-		defer r.SetFont(r.currentState.Font)
+	if style.Fit == TextFitShrink {
+		fitted, err := r.FitTextStyle(text, size, style)
+		if err != nil {
+			return err
+		}
+		style = fitted
 	}
 
-	if len(style.Borders) > 0 {
-		r.DrawBoxWithBorders(size, style.Borders...)
-	}
+	restoreStyle := r.applyTextStyle(style)
+	defer restoreStyle()
 
-	var texts []string
-	var err error
-	if len(text) > 0 {
-		texts, err = r.SplitText(text, size, style)
+	if style.Background != nil {
+		err := r.FillRectangle(size, CornerRadius{}, style.Background, 0)
+		if err != nil {
+			return err
+		}
+	} else if style.BackgroundColor != nil {
+		err := r.DrawFilledBox(size, *style.BackgroundColor)
 		if err != nil {
 			return err
 		}
 	}
 
-	r.engine.SetY(offset.Y + style.Padding.Top)
-	paddedSize := size.WithPadding(style.Padding)
+	if len(style.Borders) > 0 {
+		r.DrawBoxWithBorders(size, style.Borders...)
+	}
 
-	if !style.Multiline {
-		text := texts[0]
+	if style.Color != nil {
+		r.setTextColor(*style.Color, true)
+		defer r.SetTextColor(r.currentState.TextColor)
+	}
 
-		if len(texts) > 1 && len(style.Overflow) > 0 {
-			text = text[:len(text)-len(style.Overflow)] + style.Overflow
-		}
+	lines, textHeight, err := r.splitTextLayout(text, size, style)
+	if err != nil {
+		return err
+	}
 
-		r.engine.SetX(offset.X + style.Padding.Left)
-		return r.engine.CellWithOption(
-			paddedSize.ToRect(),
-			text,
-			gopdf.CellOption{
-				Align: int(style.Alignment),
-			},
-		)
+	paddedSize := size.WithPadding(style.Padding)
+	top := offset.Y + style.Padding.Top
+	left := offset.X + style.Padding.Left
+
+	blockHeight := TextBlockHeight(lines)
+	if style.Alignment&BottomAlignment != 0 {
+		top += paddedSize.Height - blockHeight
+	} else if style.Alignment&VerticalCenterAlignment != 0 {
+		top += (paddedSize.Height - blockHeight) / 2
 	}
 
-	textHeight, _ := r.engine.MeasureCellHeightByText(text)
-	for index, text := range texts {
+	justified := style.Alignment&JustifiedAlignment != 0
+	for index, line := range lines {
 		if index > 0 {
-			r.engine.Br(textHeight)
+			top += line.SpaceBefore
 		}
 
-		r.engine.SetX(offset.X + style.Padding.Left)
-		r.engine.CellWithOption(
-			paddedSize.ToRect(),
-			text,
-			gopdf.CellOption{
-				Align: int(style.Alignment),
-			},
+		lineOffset := NewOffset(
+			left+line.Indent,
+			top+(line.Height-textHeight)/2,
 		)
+		if line.Direction.IsRTL() {
+			lineOffset.X = left
+		}
+		lineSize := NewSize(paddedSize.Width-line.Indent, textHeight)
+		justify := justified && !line.ParagraphEnd && index < len(lines)-1
+
+		err := r.drawTextLine(
+			line.Text,
+			lineOffset,
+			lineSize,
+			style,
+			line.Direction,
+			justify,
+		)
+		if err != nil {
+			return err
+		}
+
+		top += line.Height
+		if index < len(lines)-1 {
+			top += line.SpaceAfter
+		}
 	}
 
 	return nil
 }
 
-func (r *DocumentRenderer) MeasureText(
-	text string,
-	style *TextStyle,
-	boundries Size,
-) (Size, error) {
+func (r *DocumentRenderer) applyTextStyle(style *TextStyle) func() {
 	if style.Font != nil {
 		r.setFont(*style.Font, true)
-		defer r.setFont(r.currentState.Font, false)
 	}
 
-	chunks, _ := r.SplitText(text, boundries, style)
-	fontHeight, _ := r.engine.MeasureCellHeightByText(text)
+	if style.LetterSpacing != 0 {
+		r.engine.SetCharSpacing(style.LetterSpacing)
+	}
 
-	size := boundries
-	if size.Width == 0 {
-		greaterChunk := slices.Max(chunks)
-		fontWidth, err := r.engine.MeasureTextWidth(greaterChunk)
-		if err != nil {
-			return Size{}, err
+	return func() {
+		if style.LetterSpacing != 0 {
+			r.engine.SetCharSpacing(0)
 		}
 
-		size.Width = fontWidth * fontWidth
-	}
-
-	if size.Height == 0 {
-		size.Height = fontHeight * float64(len(chunks))
+		if style.Font != nil {
+			r.setFont(r.currentState.Font, true)
+		}
 	}
-
-	return size, nil
 }
 
-func (r *DocumentRenderer) SplitText(
+func (r *DocumentRenderer) drawTextLine(
 	text string,
+	offset Offset,
 	size Size,
 	style *TextStyle,
-) ([]string, error) {
-	var texts []string
-	var err error
-
-	boundries := size.WithPadding(style.Padding)
-
-	maxRuneWidth := 0.0
-	for _, char := range text {
-		width, _ := r.engine.MeasureTextWidth(string(char))
-		if width > maxRuneWidth {
-			maxRuneWidth = width
+	direction TextDirection,
+	justify bool,
+) error {
+	alignment := style.Alignment & (LeftAlignment |
+		RightAlignment |
+		HorizontalCenterAlignment)
+
+	if direction.IsRTL() {
+		text = strings.TrimSpace(text)
+		if alignment == 0 {
+			alignment = RightAlignment
 		}
 	}
+	text = ReorderBidiText(text, direction)
 
-	if boundries.Width < maxRuneWidth {
-		boundries.Width = maxRuneWidth
-	}
-
-	if style.WordWrap {
-		texts, err = r.engine.SplitTextWithWordWrap(text, boundries.Width)
+	var x, width float64
+	var err error
+	if justify {
+		x, width, err = r.drawJustifiedLine(text, offset, size)
 	} else {
-		texts, err = r.engine.SplitText(text, boundries.Width)
+		x, width, err = r.drawAlignedLine(
+			text,
+			offset,
+			size,
+			alignment,
+			style.Strikethrough,
+		)
 	}
 
-	if len(texts) == 0 {
-		return texts, err
+	if err != nil || !style.Strikethrough {
+		return err
 	}
 
-	textHeight, _ := r.engine.MeasureCellHeightByText(texts[0])
-	limit := int(math.Trunc(boundries.Height / textHeight))
+	ascent, descent := r.MeasureFontAscent(style.Font)
+	y := offset.Y + ascent*0.7
 
-	if limit <= 0 {
-		return nil, fmt.Errorf(
-			"DocumentRenderer.SplitText: text '%s' does not fit in size %v because the text height is %v: %w",
-			text,
-			size,
-			textHeight,
-			ErrElementOverflow,
-		)
+	color := r.currentState.TextColor
+	if style.Color != nil {
+		color = *style.Color
 	}
 
-	if limit < len(texts) {
-		texts = texts[:limit]
-		if len(style.Overflow) > 0 {
-			textsLength := len(texts) - 1
+	return r.DrawLine(
+		NewSize(x+width, y),
+		NewOffset(x, y),
+		&LineOptions{
+			StrokeWidth: (ascent - descent) / 16,
+			Style:       LineStyleSolid,
+			Color:       &color,
+		},
+	)
+}
 
-			lastText := texts[textsLength]
-			lastTextLength := len(lastText)
-			lastText = lastText[:lastTextLength-len(style.Overflow)] + style.Overflow
+func (r *DocumentRenderer) drawJustifiedLine(
+	text string,
+	offset Offset,
+	size Size,
+) (float64, float64, error) {
+	words := strings.Fields(text)
+	if len(words) < 2 {
+		return r.drawAlignedLine(text, offset, size, LeftAlignment, true)
+	}
 
-			texts[textsLength] = lastText
+	widths := make([]float64, len(words))
+	var wordsWidth float64
+	for index, word := range words {
+		width, err := r.measureTextWidth(word)
+		if err != nil {
+			return 0, 0, err
 		}
+		widths[index] = width
+		wordsWidth += width
 	}
 
-	return texts, err
+	gap := (size.Width - wordsWidth) / float64(len(words)-1)
+	x := offset.X
+	for index, word := range words {
+		wordSize := NewSize(widths[index], size.Height)
+		_, _, err := r.drawAlignedLine(
+			word,
+			NewOffset(x, offset.Y),
+			wordSize,
+			LeftAlignment,
+			false,
+		)
+		if err != nil {
+			return 0, 0, err
+		}
+		x += widths[index] + gap
+	}
+
+	return offset.X, size.Width, nil
 }
 
-func (r *DocumentRenderer) DrawLine(
-	size Size,
+func (r *DocumentRenderer) drawAlignedLine(
+	text string,
 	offset Offset,
-	options *LineOptions,
-) error {
-	if size.IsZero() {
-		return ErrInvalidSize
+	size Size,
+	alignment Alignment,
+	measure bool,
+) (float64, float64, error) {
+	runs, err := r.splitFontRuns(text)
+	if err != nil {
+		return 0, 0, err
 	}
 
-	if options != nil {
-		if options.StrokeWidth != r.currentState.StrokeWidth {
-			r.setStrokeWidth(options.StrokeWidth, true)
-			defer r.SetStrokeWidth(r.currentState.StrokeWidth)
-		}
-
-		if options.Color != nil &&
-			!r.currentState.StrokeColor.IsEqual(*options.Color) {
-			r.setStrokeColor(*options.Color, true)
-			defer r.SetStrokeColor(r.currentState.StrokeColor)
+	var width float64
+	if len(runs) == 1 && runs[0].Family == r.activeFont.Family {
+		r.SetOffset(offset)
+		err = r.engine.CellWithOption(
+			size.ToRect(),
+			text,
+			gopdf.CellOption{
+				Align: int(alignment),
+			},
+		)
+		if err == nil && measure {
+			width, err = r.engine.MeasureTextWidth(text)
 		}
+	} else {
+		width, err = r.measureFontRuns(runs)
 	}
 
-	if size.Width == 0 {
-		size.Width = r.engine.GetX()
+	if err != nil {
+		return 0, 0, err
 	}
 
-	if size.Height == 0 {
-		size.Height = r.engine.GetY()
+	x := offset.X
+	if alignment&RightAlignment != 0 {
+		x += size.Width - width
+	} else if alignment&HorizontalCenterAlignment != 0 {
+		x += (size.Width - width) / 2
 	}
 
-	r.setLineStyle(options.Style, true)
-	defer r.SetLineStyle(r.currentState.LineStyle)
+	if len(runs) > 1 || runs[0].Family != r.activeFont.Family {
+		err = r.drawFontRuns(runs, x, offset.Y)
+	}
 
-	r.engine.Line(offset.X, offset.Y, size.Width, size.Height)
-	return nil
+	return x, width, err
 }
 
-func (r *DocumentRenderer) DrawHorizontalLine(
-	width float64,
-	options *LineOptions,
+func (r *DocumentRenderer) drawFontRuns(
+	runs []fontRun,
+	x float64,
+	y float64,
 ) error {
-	return r.DrawLine(NewSize(width, 0), r.GetCurrentOffset(), options)
-}
+	baseline := y + r.fontAscent(r.activeFont.Family, r.activeFont.Style)
+	for _, run := range runs {
+		restoreFont := r.useFontRun(run)
+		width, err := r.engine.MeasureTextWidth(run.Text)
+		if err == nil {
+			r.engine.SetXY(x, baseline)
+			err = r.engine.Text(run.Text)
+		}
+		restoreFont()
 
-func (r *DocumentRenderer) DrawHorizontalLineWithOffset(
-	width float64,
-	offset Offset,
-	options *LineOptions,
-) error {
-	return r.DrawLine(NewSize(width, offset.Y), offset, options)
+		if err != nil {
+			return err
+		}
+		x += width
+	}
+
+	return nil
 }
 
-func (r *DocumentRenderer) DrawVerticalLine(
-	height float64,
-	options *LineOptions,
-) error {
-	return r.DrawLine(NewSize(0, height), r.GetCurrentOffset(), options)
+func (r *DocumentRenderer) fontAscent(family string, style int) float64 {
+	metrics, _, _ := r.fontGlyphs(family, style)
+	return metrics.Ascender * r.activeFont.Size
 }
 
-func (r *DocumentRenderer) DrawVerticalLineWithOffset(
-	height float64,
-	offset Offset,
-	options *LineOptions,
-) error {
-	return r.DrawLine(NewSize(offset.X, height), offset, options)
+func (r *DocumentRenderer) fontGlyphs(
+	family string,
+	style int,
+) (fontMetrics, int, bool) {
+	underline := style & gopdf.Underline
+	metrics, ok := r.fontMetrics[fontKey(family, style&^gopdf.Underline)]
+	if ok {
+		return metrics, style, true
+	}
+
+	metrics, ok = r.fontMetrics[fontKey(family, gopdf.Regular)]
+	return metrics, gopdf.Regular | underline, ok
 }
 
-func (r *DocumentRenderer) DrawBox(size Size, lineOptions *LineOptions) error {
-	if size.IsZero() {
-		err := errors.New("DrawBox can't have a zero Size")
-		return ErrInvalidSize.Wrap(err)
+func (r *DocumentRenderer) splitFontRuns(text string) ([]fontRun, error) {
+	font := r.activeFont
+	primary := fontRun{Text: text, Family: font.Family, Style: font.Style}
+	if len(font.Fallbacks) == 0 && !font.Strict {
+		return []fontRun{primary}, nil
 	}
 
-	currentOffset := r.GetCurrentOffset()
-	if lineOptions == nil {
-		lineOptions = &LineOptions{
-			Style:       LineStyleSolid,
-			StrokeWidth: 0,
-			Color:       &Color{0, 0, 0},
-		}
+	metrics, _, ok := r.fontGlyphs(font.Family, font.Style)
+	if !ok || len(text) == 0 {
+		return []fontRun{primary}, nil
 	}
 
-	r.DrawVerticalLine(size.Height+currentOffset.Y, lineOptions)
+	var runs []fontRun
+	var unsupported []rune
+	for _, cluster := range splitGraphemes(text) {
+		char, _ := utf8.DecodeRuneInString(cluster)
+
+		run := fontRun{Family: font.Family, Style: font.Style}
+		if len(runs) > 0 && (unicode.IsSpace(char) || unicode.IsControl(char)) {
+			run = runs[len(runs)-1]
+		} else if metrics.Glyphs[int(char)] == 0 {
+			found := false
+			for _, fallback := range font.Fallbacks {
+				fallbackMetrics, style, ok := r.fontGlyphs(fallback, font.Style)
+				if ok && fallbackMetrics.Glyphs[int(char)] != 0 {
+					run = fontRun{Family: fallback, Style: style}
+					found = true
+					break
+				}
+			}
+
+			if !found && !slices.Contains(unsupported, char) {
+				unsupported = append(unsupported, char)
+			}
+		}
+
+		last := len(runs) - 1
+		if last >= 0 && runs[last].Family == run.Family && runs[last].Style == run.Style {
+			runs[last].Text += cluster
+		} else {
+			run.Text = cluster
+			runs = append(runs, run)
+		}
+	}
+
+	if font.Strict && len(unsupported) > 0 {
+		chars := make([]string, len(unsupported))
+		for index, char := range unsupported {
+			chars[index] = fmt.Sprintf("%q (%U)", char, char)
+		}
+
+		return nil, ErrUnsupportedGlyph.Wrap(fmt.Errorf(
+			"font '%s' and its fallbacks have no glyphs for %s",
+			font.Family,
+			strings.Join(chars, ", "),
+		))
+	}
+
+	return runs, nil
+}
+
+func (r *DocumentRenderer) useFontRun(run fontRun) func() {
+	font := r.activeFont
+	if run.Family == font.Family && run.Style == font.Style {
+		return func() {}
+	}
+
+	r.engine.SetFontWithStyle(run.Family, run.Style, font.Size)
+	return func() {
+		r.engine.SetFontWithStyle(font.Family, font.Style, font.Size)
+	}
+}
+
+func (r *DocumentRenderer) measureFontRuns(runs []fontRun) (float64, error) {
+	var width float64
+	for _, run := range runs {
+		restoreFont := r.useFontRun(run)
+		runWidth, err := r.engine.MeasureTextWidth(run.Text)
+		restoreFont()
+
+		if err != nil {
+			return 0, err
+		}
+		width += runWidth
+	}
+
+	return width, nil
+}
+
+func (r *DocumentRenderer) measureTextWidth(text string) (float64, error) {
+	runs, err := r.splitFontRuns(text)
+	if err != nil {
+		return 0, err
+	}
+	return r.measureFontRuns(runs)
+}
+
+func textBaseline(
+	alignment Alignment,
+	height float64,
+	ascent float64,
+	descent float64,
+) float64 {
+	switch {
+	case alignment&BottomAlignment != 0:
+		return height + descent
+	case alignment&VerticalCenterAlignment != 0:
+		return height/2 + (ascent+descent)/2
+	default:
+		return ascent
+	}
+}
+
+func (r *DocumentRenderer) MeasureFontAscent(font *Font) (float64, float64) {
+	current := r.currentState.Font
+	if font == nil {
+		font = &current
+	}
+
+	family := current.Family
+	if len(font.Family) > 0 {
+		family = font.Family
+	}
+
+	size := current.Size
+	if font.Size > 0 {
+		size = font.Size
+	}
+
+	style := current.Style.Combine()
+	if font.Style != nil {
+		style = font.Style.Combine()
+	}
+
+	family, _ = r.loadFontFamily(family, style)
+	metrics, _, _ := r.fontGlyphs(family, style)
+
+	return metrics.Ascender * size, metrics.Descender * size
+}
+
+func (r *DocumentRenderer) MeasureText(
+	text string,
+	style *TextStyle,
+	boundries Size,
+) (Size, error) {
+	restoreStyle := r.applyTextStyle(style)
+	defer restoreStyle()
+
+	size := boundries
+	if size.Width == 0 || size.Width == MaxSize {
+		paragraphs := []string{text}
+		if style.Multiline {
+			paragraphs = strings.Split(text, "\n")
+		}
+
+		var width float64
+		for _, paragraph := range paragraphs {
+			paragraphWidth, err := r.measureTextWidth(paragraph)
+			if err != nil {
+				return Size{}, err
+			}
+			width = max(width, paragraphWidth+style.FirstLineIndent)
+		}
+
+		size.Width = width + style.Padding.Left + style.Padding.Right
+	}
+
+	if size.Height == 0 || size.Height == MaxSize {
+		lines, _, err := r.layoutText(
+			text,
+			size.WithPadding(style.Padding).Width,
+			style,
+		)
+		if err != nil {
+			return Size{}, err
+		}
+
+		if !style.Multiline && len(lines) > 1 {
+			lines = lines[:1]
+		}
+
+		size.Height = TextBlockHeight(lines) +
+			style.Padding.Top + style.Padding.Bottom
+	}
+
+	return size, nil
+}
+
+type TextLine struct {
+	Text           string
+	SourceStart    int
+	SourceEnd      int
+	Direction      TextDirection
+	Hyphenated     bool
+	Indent         float64
+	Height         float64
+	SpaceBefore    float64
+	SpaceAfter     float64
+	ParagraphStart bool
+	ParagraphEnd   bool
+}
+
+func (l TextLine) SourceText() string {
+	if l.Hyphenated {
+		return strings.TrimSuffix(l.Text, "-")
+	}
+	return l.Text
+}
+
+func TextBlockHeight(lines []TextLine) float64 {
+	var height float64
+	for index, line := range lines {
+		height += line.Height
+		if index > 0 {
+			height += line.SpaceBefore
+		}
+		if index < len(lines)-1 {
+			height += line.SpaceAfter
+		}
+	}
+	return height
+}
+
+func FitTextLines(lines []TextLine, height float64) int {
+	var used float64
+	for index, line := range lines {
+		if index > 0 {
+			used += line.SpaceBefore
+		}
+
+		used += line.Height
+		if used > height+sizeTolerance {
+			return index
+		}
+		used += line.SpaceAfter
+	}
+	return len(lines)
+}
+
+func (r *DocumentRenderer) SplitTextLines(
+	text string,
+	width float64,
+	style *TextStyle,
+) ([]TextLine, error) {
+	restoreStyle := r.applyTextStyle(style)
+	defer restoreStyle()
+
+	lines, _, err := r.layoutText(
+		text,
+		width-style.Padding.Left-style.Padding.Right,
+		style,
+	)
+	return lines, err
+}
+
+func (r *DocumentRenderer) SplitText(
+	text string,
+	size Size,
+	style *TextStyle,
+) ([]string, error) {
+	restoreStyle := r.applyTextStyle(style)
+	defer restoreStyle()
+
+	lines, _, err := r.splitTextLayout(text, size, style)
+
+	texts := make([]string, len(lines))
+	for index, line := range lines {
+		texts[index] = line.Text
+	}
+
+	return texts, err
+}
+
+func (r *DocumentRenderer) FitTextStyle(
+	text string,
+	size Size,
+	style *TextStyle,
+) (*TextStyle, error) {
+	font := r.currentState.Font
+	if style.Font != nil {
+		font = style.Font.Merge(font)
+	}
+
+	minFontSize := style.MinFontSize
+	if minFontSize <= 0 {
+		minFontSize = defaultMinFontSize
+	}
+
+	step := style.FontSizeStep
+	if step <= 0 {
+		step = defaultFontSizeStep
+	}
+
+	fitted := *style
+	fontSize := font.Size
+	for {
+		fittedFont := font
+		fittedFont.Size = max(fontSize, minFontSize)
+		fitted.Font = &fittedFont
+
+		fits, err := r.textFits(text, size, &fitted)
+		if err != nil {
+			return nil, err
+		}
+
+		if fits {
+			return &fitted, nil
+		}
+
+		if fittedFont.Size <= minFontSize {
+			break
+		}
+		fontSize -= step
+	}
+
+	if len(fitted.Overflow) == 0 {
+		fitted.Overflow = defaultTextOverflow
+	}
+
+	return &fitted, nil
+}
+
+func (r *DocumentRenderer) textFits(
+	text string,
+	size Size,
+	style *TextStyle,
+) (bool, error) {
+	restoreStyle := r.applyTextStyle(style)
+	defer restoreStyle()
+
+	boundries := size.WithPadding(style.Padding)
+	lines, _, err := r.layoutText(text, boundries.Width, style)
+	if err != nil {
+		return false, err
+	}
+
+	if !style.Multiline && len(lines) > 1 {
+		return false, nil
+	}
+
+	return FitTextLines(lines, boundries.Height) == len(lines), nil
+}
+
+func (r *DocumentRenderer) splitTextLayout(
+	text string,
+	size Size,
+	style *TextStyle,
+) ([]TextLine, float64, error) {
+	boundries := size.WithPadding(style.Padding)
+	lines, textHeight, err := r.layoutText(text, boundries.Width, style)
+	if len(lines) == 0 || err != nil {
+		return lines, textHeight, err
+	}
+
+	limit := len(lines)
+	if !style.Multiline {
+		limit = 1
+	}
+
+	if size.Height != MaxSize {
+		limit = min(limit, FitTextLines(lines, boundries.Height))
+	}
+
+	if limit <= 0 {
+		return nil, textHeight, fmt.Errorf(
+			"DocumentRenderer.SplitText: text '%s' does not fit in size %v because the text height is %v: %w",
+			text,
+			size,
+			textHeight,
+			ErrElementOverflow,
+		)
+	}
+
+	if limit < len(lines) {
+		lines = lines[:limit]
+		if len(style.Overflow) > 0 {
+			last := &lines[limit-1]
+			last.Text, err = r.TruncateText(
+				last.Text,
+				style.Overflow,
+				boundries.Width-last.Indent,
+			)
+			if err != nil {
+				return nil, textHeight, err
+			}
+		}
+	}
+
+	return lines, textHeight, nil
+}
+
+func (r *DocumentRenderer) TruncateText(
+	text string,
+	marker string,
+	width float64,
+) (string, error) {
+	markerWidth, err := r.measureTextWidth(marker)
+	if err != nil {
+		return text, err
+	}
+
+	clusters := splitGraphemes(text)
+	for len(clusters) > 0 {
+		truncated := strings.TrimRightFunc(
+			strings.Join(clusters, ""),
+			unicode.IsSpace,
+		)
+
+		truncatedWidth, err := r.measureTextWidth(truncated)
+		if err != nil {
+			return text, err
+		}
+
+		if truncatedWidth+markerWidth <= width+sizeTolerance {
+			return truncated + marker, nil
+		}
+
+		clusters = clusters[:len(clusters)-1]
+	}
+
+	return marker, nil
+}
+
+const zeroWidthJoiner rune = 0x200D
+
+func splitGraphemes(text string) []string {
+	var clusters []string
+	joinNext := false
+	for _, char := range text {
+		extends := joinNext ||
+			unicode.In(char, unicode.Mn, unicode.Me, unicode.Mc) ||
+			unicode.Is(unicode.Variation_Selector, char) ||
+			char == zeroWidthJoiner ||
+			(char >= 0x1F3FB && char <= 0x1F3FF)
+
+		if extends && len(clusters) > 0 {
+			clusters[len(clusters)-1] += string(char)
+		} else {
+			clusters = append(clusters, string(char))
+		}
+
+		joinNext = char == zeroWidthJoiner
+	}
+	return clusters
+}
+
+func (r *DocumentRenderer) layoutText(
+	text string,
+	width float64,
+	style *TextStyle,
+) ([]TextLine, float64, error) {
+	if len(text) == 0 {
+		return nil, 0, nil
+	}
+
+	textHeight, err := r.engine.MeasureCellHeightByText(text)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	advance := textHeight
+	if style.LineHeight > 0 {
+		advance = style.LineHeight
+	} else if style.LineSpacing > 0 {
+		advance = textHeight * style.LineSpacing
+	}
+
+	var lines []TextLine
+	var paragraphStart int
+	paragraphs := strings.Split(text, "\n")
+	for paragraphIndex, paragraph := range paragraphs {
+		wrapped, err := r.wrapParagraph(paragraph, width, style)
+		if err != nil {
+			return nil, textHeight, err
+		}
+
+		var cursor int
+		direction := style.Direction.Resolve(paragraph)
+		for index, line := range wrapped {
+			source := line.SourceText()
+			if position := strings.Index(paragraph[cursor:], source); position >= 0 {
+				cursor += position
+			}
+			line.SourceStart = paragraphStart + cursor
+			cursor = min(cursor+len(source), len(paragraph))
+			line.SourceEnd = paragraphStart + cursor
+			line.Direction = direction
+
+			line.Indent = style.HangingIndent
+			line.Height = advance
+
+			if index == 0 {
+				line.ParagraphStart = true
+				line.Indent = style.FirstLineIndent
+				if paragraphIndex > 0 {
+					line.SpaceBefore = style.ParagraphSpacingBefore
+				}
+			}
+
+			if index == len(wrapped)-1 {
+				line.ParagraphEnd = true
+				if paragraphIndex < len(paragraphs)-1 {
+					line.SpaceAfter = style.ParagraphSpacingAfter
+				}
+			}
+
+			lines = append(lines, line)
+		}
+		paragraphStart += len(paragraph) + 1
+	}
+
+	return lines, textHeight, nil
+}
+
+func (r *DocumentRenderer) wrapParagraph(
+	paragraph string,
+	width float64,
+	style *TextStyle,
+) ([]TextLine, error) {
+	if len(paragraph) == 0 {
+		return []TextLine{{}}, nil
+	}
+
+	firstWidth := width - style.FirstLineIndent
+	restWidth := width - style.HangingIndent
+
+	lines, err := r.wrapText(paragraph, firstWidth, style)
+	if err != nil || len(lines) < 2 || firstWidth == restWidth {
+		return lines, err
+	}
+
+	rest := strings.TrimLeftFunc(
+		strings.TrimPrefix(paragraph, lines[0].SourceText()),
+		unicode.IsSpace,
+	)
+	restLines, err := r.wrapText(rest, restWidth, style)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(lines[:1], restLines...), nil
+}
+
+func (r *DocumentRenderer) wrapText(
+	text string,
+	width float64,
+	style *TextStyle,
+) ([]TextLine, error) {
+	maxRuneWidth := 0.0
+	for _, char := range text {
+		width, err := r.measureTextWidth(string(char))
+		if err != nil {
+			return nil, err
+		}
+		if width > maxRuneWidth {
+			maxRuneWidth = width
+		}
+	}
+
+	if width < maxRuneWidth {
+		width = maxRuneWidth
+	}
+
+	var texts []string
+	var err error
+	if style.WordWrap && len(style.Hyphenation) > 0 {
+		hyphenator, err := GetHyphenator(style.Hyphenation)
+		if err != nil {
+			return nil, err
+		}
+		return r.wrapHyphenatedText(text, width, hyphenator)
+	} else if style.WordWrap && len(r.activeFont.Fallbacks) > 0 {
+		return r.wrapHyphenatedText(text, width, nil)
+	} else if style.WordWrap {
+		texts, err = r.engine.SplitTextWithWordWrap(text, width)
+	} else {
+		texts, err = r.engine.SplitText(text, width)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	lines := make([]TextLine, len(texts))
+	for index, text := range texts {
+		lines[index].Text = text
+	}
+
+	return lines, nil
+}
+
+func (r *DocumentRenderer) wrapHyphenatedText(
+	text string,
+	width float64,
+	hyphenator Hyphenator,
+) ([]TextLine, error) {
+	var lines []TextLine
+	var line string
+	for _, token := range splitWordTokens(text) {
+		fits, err := r.textFitsWidth(line+token, width)
+		if err != nil {
+			return nil, err
+		}
+
+		if fits {
+			line += token
+			continue
+		}
+
+		head, tail, err := r.hyphenateToFit(line, token, width, hyphenator)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(head) > 0 {
+			lines = append(lines, TextLine{Text: line + head + "-", Hyphenated: true})
+			line = tail
+		} else {
+			if len(line) > 0 {
+				lines = append(lines, TextLine{Text: line})
+			}
+			line = strings.TrimLeftFunc(token, unicode.IsSpace)
+		}
+
+		for {
+			fits, err := r.textFitsWidth(line, width)
+			if err != nil {
+				return nil, err
+			}
+
+			if fits {
+				break
+			}
+
+			head, tail, err := r.hyphenateToFit("", line, width, hyphenator)
+			if err != nil {
+				return nil, err
+			}
+
+			if len(head) > 0 {
+				lines = append(lines, TextLine{Text: head + "-", Hyphenated: true})
+				line = tail
+				continue
+			}
+
+			parts, err := r.engine.SplitText(line, width)
+			if err != nil {
+				return nil, err
+			}
+
+			if len(parts) < 2 {
+				break
+			}
+
+			lines = append(lines, TextLine{Text: parts[0]})
+			line = strings.TrimPrefix(line, parts[0])
+		}
+	}
+
+	return append(lines, TextLine{Text: line}), nil
+}
+
+func (r *DocumentRenderer) hyphenateToFit(
+	prefix string,
+	token string,
+	width float64,
+	hyphenator Hyphenator,
+) (string, string, error) {
+	if hyphenator == nil {
+		return "", token, nil
+	}
+
+	trimmed := strings.TrimLeftFunc(token, unicode.IsSpace)
+	space := token[:len(token)-len(trimmed)]
+	word := []rune(trimmed)
+
+	start := 0
+	for start < len(word) && !unicode.IsLetter(word[start]) {
+		start++
+	}
+
+	end := start
+	for end < len(word) && unicode.IsLetter(word[end]) {
+		end++
+	}
+
+	points := hyphenator.Hyphenate(string(word[start:end]))
+	for index := len(points) - 1; index >= 0; index-- {
+		point := start + points[index]
+		head := space + string(word[:point])
+
+		fits, err := r.textFitsWidth(prefix+head+"-", width)
+		if err != nil {
+			return "", token, err
+		}
+
+		if fits {
+			return head, string(word[point:]), nil
+		}
+	}
+
+	return "", token, nil
+}
+
+func (r *DocumentRenderer) textFitsWidth(text string, width float64) (bool, error) {
+	textWidth, err := r.measureTextWidth(text)
+	if err != nil {
+		return false, err
+	}
+	return textWidth <= width+sizeTolerance, nil
+}
+
+func splitWordTokens(text string) []string {
+	var tokens []string
+	start := 0
+	inWord := false
+	for index, char := range text {
+		isSpace := unicode.IsSpace(char)
+		if isSpace && inWord {
+			tokens = append(tokens, text[start:index])
+			start = index
+		}
+		inWord = !isSpace
+	}
+
+	if start < len(text) {
+		tokens = append(tokens, text[start:])
+	}
+
+	return tokens
+}
+
+func (r *DocumentRenderer) measureTextWithFont(
+	text string,
+	font Font,
+) (float64, error) {
+	r.setFont(font, true)
+	defer r.setFont(r.currentState.Font, true)
+
+	return r.engine.MeasureTextWidth(text)
+}
+
+func (r *DocumentRenderer) SplitRichText(
+	spans []TextSpan,
+	size Size,
+	style *TextStyle,
+) ([]RichTextLine, error) {
+	boundries := size.WithPadding(style.Padding)
+	if size.Width == 0 || size.Width == MaxSize {
+		boundries.Width = MaxSize
+	}
+
+	baseFont := r.currentState.Font
+	if style.Font != nil {
+		baseFont = style.Font.Merge(baseFont)
+	}
+
+	var lines []RichTextLine
+	line := RichTextLine{}
+	pushLine := func() {
+		if count := len(line.Runs); count > 0 {
+			last := &line.Runs[count-1]
+			trimmed := strings.TrimRight(last.Text, " \t")
+			if trimmed != last.Text {
+				width, _ := r.measureTextWithFont(trimmed, last.Font)
+				line.Width -= last.Width - width
+				last.Text = trimmed
+				last.Width = width
+			}
+		}
+		lines = append(lines, line)
+		line = RichTextLine{}
+	}
+
+	for index := range spans {
+		span := &spans[index]
+		font := baseFont
+		if span.Font != nil {
+			font = span.Font.Merge(baseFont)
+		}
+
+		ascent, descent := r.MeasureFontAscent(&font)
+		addRun := func(text string, width float64) {
+			count := len(line.Runs)
+			if count > 0 && line.Runs[count-1].Span == span {
+				line.Runs[count-1].Text += text
+				line.Runs[count-1].Width += width
+			} else {
+				line.Runs = append(line.Runs, RichTextRun{
+					Span:  span,
+					Font:  font,
+					Text:  text,
+					Width: width,
+				})
+			}
+			line.Width += width
+			line.Height = max(line.Height, ascent-descent)
+			line.Ascent = max(line.Ascent, ascent)
+		}
+
+		for _, token := range splitRichTextTokens(span.Text) {
+			if token == "\n" {
+				line.Height = max(line.Height, ascent-descent)
+				line.Ascent = max(line.Ascent, ascent)
+				pushLine()
+				continue
+			}
+
+			width, err := r.measureTextWithFont(token, font)
+			if err != nil {
+				return nil, err
+			}
+
+			wordWidth := width
+			if word := strings.TrimRight(token, " \t"); word != token {
+				wordWidth, err = r.measureTextWithFont(word, font)
+				if err != nil {
+					return nil, err
+				}
+			}
+
+			if len(line.Runs) > 0 && line.Width+wordWidth > boundries.Width {
+				if strings.TrimSpace(token) == "" {
+					continue
+				}
+				pushLine()
+			}
+
+			if wordWidth <= boundries.Width-line.Width {
+				addRun(token, width)
+				continue
+			}
+
+			var chunk []rune
+			var chunkWidth float64
+			for _, char := range token {
+				charWidth, err := r.measureTextWithFont(string(char), font)
+				if err != nil {
+					return nil, err
+				}
+
+				if len(chunk) > 0 &&
+					line.Width+chunkWidth+charWidth > boundries.Width {
+					addRun(string(chunk), chunkWidth)
+					pushLine()
+					chunk, chunkWidth = nil, 0
+				}
+				chunk = append(chunk, char)
+				chunkWidth += charWidth
+			}
+
+			if len(chunk) > 0 {
+				addRun(string(chunk), chunkWidth)
+			}
+		}
+	}
+
+	if len(line.Runs) > 0 {
+		pushLine()
+	}
+
+	if size.Height > 0 && size.Height != MaxSize {
+		var height float64
+		for index, line := range lines {
+			height += line.Height
+			if height > boundries.Height+sizeTolerance {
+				lines = lines[:index]
+				break
+			}
+		}
+	}
+
+	return lines, nil
+}
+
+func (r *DocumentRenderer) MeasureRichText(
+	spans []TextSpan,
+	style *TextStyle,
+	boundries Size,
+) (Size, error) {
+	lines, err := r.SplitRichText(spans, NewWidth(boundries.Width), style)
+	if err != nil {
+		return Size{}, err
+	}
+
+	size := boundries
+	if size.Width == 0 || size.Width == MaxSize {
+		var width float64
+		for _, line := range lines {
+			width = max(width, line.Width)
+		}
+		size.Width = width + style.Padding.Left + style.Padding.Right
+	}
+
+	if size.Height == 0 || size.Height == MaxSize {
+		var height float64
+		for _, line := range lines {
+			height += line.Height
+		}
+		size.Height = height + style.Padding.Top + style.Padding.Bottom
+	}
+
+	return size, nil
+}
+
+func (r *DocumentRenderer) DrawRichText(
+	spans []TextSpan,
+	size Size,
+	style *TextStyle,
+) error {
+	offset := r.GetCurrentOffset()
+	defer r.SetOffset(offset)
+
+	if len(style.Borders) > 0 {
+		r.DrawBoxWithBorders(size, style.Borders...)
+	}
+
+	lines, err := r.SplitRichText(spans, size, style)
+	if err != nil {
+		return err
+	}
+
+	paddedSize := size.WithPadding(style.Padding)
+	y := offset.Y + style.Padding.Top
+	for _, line := range lines {
+		x := offset.X + style.Padding.Left
+		if style.Alignment&RightAlignment != 0 {
+			x += paddedSize.Width - line.Width
+		} else if style.Alignment&HorizontalCenterAlignment != 0 {
+			x += (paddedSize.Width - line.Width) / 2
+		}
+
+		baseline := y + line.Ascent
+		for _, run := range line.Runs {
+			if err := r.drawTextRun(run, x, baseline); err != nil {
+				return err
+			}
+			x += run.Width
+		}
+		y += line.Height
+	}
+
+	return nil
+}
+
+func (r *DocumentRenderer) drawTextRun(
+	run RichTextRun,
+	x float64,
+	baseline float64,
+) error {
+	if len(run.Text) == 0 {
+		return nil
+	}
+
+	r.setFont(run.Font, true)
+	defer r.setFont(r.currentState.Font, true)
+
+	if run.Span.Color != nil {
+		r.setTextColor(*run.Span.Color, true)
+		defer r.SetTextColor(r.currentState.TextColor)
+	}
+
+	r.engine.SetXY(x, baseline)
+	if err := r.engine.Text(run.Text); err != nil {
+		return err
+	}
+
+	ascent, descent := r.MeasureFontAscent(&run.Font)
+	lineOptions := &LineOptions{
+		StrokeWidth: run.Font.Size / 16,
+		Style:       LineStyleSolid,
+		Color:       run.Span.Color,
+	}
+
+	if run.Span.Underline {
+		y := baseline - descent/2
+		r.DrawLine(NewSize(x+run.Width, y), NewOffset(x, y), lineOptions)
+	}
+
+	if run.Span.Strikethrough {
+		y := baseline - ascent*0.3
+		r.DrawLine(NewSize(x+run.Width, y), NewOffset(x, y), lineOptions)
+	}
+
+	if len(run.Span.Link) > 0 {
+		top := baseline - ascent
+		height := ascent - descent
+		if anchor, ok := strings.CutPrefix(run.Span.Link, "#"); ok {
+			r.engine.AddInternalLink(anchor, x, top, run.Width, height)
+		} else {
+			r.engine.AddExternalLink(run.Span.Link, x, top, run.Width, height)
+		}
+	}
+
+	return nil
+}
+
+func (r *DocumentRenderer) DrawLine(
+	size Size,
+	offset Offset,
+	options *LineOptions,
+) error {
+	if size.IsZero() {
+		return ErrInvalidSize
+	}
+
+	if options != nil {
+		if options.StrokeWidth != r.currentState.StrokeWidth {
+			r.setStrokeWidth(options.StrokeWidth, true)
+			defer r.SetStrokeWidth(r.currentState.StrokeWidth)
+		}
+
+		if options.Color != nil &&
+			!r.currentState.StrokeColor.IsEqual(*options.Color) {
+			r.setStrokeColor(*options.Color, true)
+			defer r.SetStrokeColor(r.currentState.StrokeColor)
+		}
+	}
+
+	if size.Width == 0 {
+		size.Width = r.engine.GetX()
+	}
+
+	if size.Height == 0 {
+		size.Height = r.engine.GetY()
+	}
+
+	r.setLineStyle(options.Style, true)
+	defer r.SetLineStyle(r.currentState.LineStyle)
+
+	r.engine.Line(offset.X, offset.Y, size.Width, size.Height)
+	return nil
+}
+
+func (r *DocumentRenderer) DrawHorizontalLine(
+	width float64,
+	options *LineOptions,
+) error {
+	return r.DrawLine(NewSize(width, 0), r.GetCurrentOffset(), options)
+}
+
+func (r *DocumentRenderer) DrawHorizontalLineWithOffset(
+	width float64,
+	offset Offset,
+	options *LineOptions,
+) error {
+	return r.DrawLine(NewSize(width, offset.Y), offset, options)
+}
+
+func (r *DocumentRenderer) DrawVerticalLine(
+	height float64,
+	options *LineOptions,
+) error {
+	return r.DrawLine(NewSize(0, height), r.GetCurrentOffset(), options)
+}
+
+func (r *DocumentRenderer) DrawVerticalLineWithOffset(
+	height float64,
+	offset Offset,
+	options *LineOptions,
+) error {
+	return r.DrawLine(NewSize(offset.X, height), offset, options)
+}
+
+func (r *DocumentRenderer) DrawBox(size Size, lineOptions *LineOptions) error {
+	if size.IsZero() {
+		err := errors.New("DrawBox can't have a zero Size")
+		return ErrInvalidSize.Wrap(err)
+	}
+
+	currentOffset := r.GetCurrentOffset()
+	if lineOptions == nil {
+		lineOptions = &LineOptions{
+			Style:       LineStyleSolid,
+			StrokeWidth: 0,
+			Color:       &Color{0, 0, 0},
+		}
+	}
+
+	r.DrawVerticalLine(size.Height+currentOffset.Y, lineOptions)
 
 	offset := NewOffset(size.Width+currentOffset.X, currentOffset.Y)
 	r.DrawVerticalLineWithOffset(
@@ -854,6 +2140,27 @@ func (r *DocumentRenderer) DrawBox(size Size, lineOptions *LineOptions) error {
 	return nil
 }
 
+func (r *DocumentRenderer) DrawFilledBox(size Size, color Color) error {
+	if size.HasZeroValue() {
+		err := errors.New("DrawFilledBox can't have a zero Size")
+		return ErrInvalidSize.Wrap(err)
+	}
+
+	r.setFillColor(color, true)
+	defer r.SetFillColor(r.currentState.FillColor)
+
+	offset := r.GetCurrentOffset()
+	r.engine.RectFromUpperLeftWithStyle(
+		offset.X,
+		offset.Y,
+		size.Width,
+		size.Height,
+		"F",
+	)
+
+	return nil
+}
+
 func (r *DocumentRenderer) DrawBoxWithBorders(
 	size Size,
 	borders ...Border,
@@ -899,6 +2206,245 @@ func (r *DocumentRenderer) DrawBoxWithBorders(
 	return nil
 }
 
+func (r *DocumentRenderer) DrawRoundedBoxWithBorders(
+	size Size,
+	radius CornerRadius,
+	borders ...Border,
+) error {
+	if size.IsZero() {
+		err := errors.New("RoundedBoxWithBorders can't have a zero Size")
+		return ErrInvalidSize.Wrap(err)
+	}
+
+	if radius.IsZero() {
+		return r.DrawBoxWithBorders(size, borders...)
+	}
+
+	for _, border := range borders {
+		if !border.Side.IsValid() {
+			continue
+		}
+
+		options := border.Options
+		commands := roundedBorderPath(size, radius, border.Side)
+		err := r.drawShape(flattenPath(commands), ShapeOptions{Stroke: &options})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *DocumentRenderer) FillRectangle(
+	size Size,
+	radius CornerRadius,
+	paint Paint,
+	opacity float64,
+) error {
+	if size.HasZeroValue() {
+		err := errors.New("FillRectangle can't have a zero Size")
+		return ErrInvalidSize.Wrap(err)
+	}
+
+	return paint.fill(r, rectanglePath(size, radius), opacity)
+}
+
+func (r *DocumentRenderer) DrawRectangle(
+	size Size,
+	radius CornerRadius,
+	options ShapeOptions,
+) error {
+	if size.HasZeroValue() {
+		err := errors.New("DrawRectangle can't have a zero Size")
+		return ErrInvalidSize.Wrap(err)
+	}
+
+	return r.drawShape(rectanglePath(size, radius), options)
+}
+
+func (r *DocumentRenderer) DrawEllipse(size Size, options ShapeOptions) error {
+	if size.HasZeroValue() {
+		err := errors.New("DrawEllipse can't have a zero Size")
+		return ErrInvalidSize.Wrap(err)
+	}
+
+	return r.drawShape(ellipsePath(size), options)
+}
+
+func (r *DocumentRenderer) DrawPolygon(
+	points []Offset,
+	options ShapeOptions,
+) error {
+	if len(points) < 3 {
+		err := errors.New("DrawPolygon needs at least 3 points")
+		return ErrInvalidArgument.Wrap(err)
+	}
+
+	subpath := shapeSubpath{Points: points, Closed: true}
+	return r.drawShape([]shapeSubpath{subpath}, options)
+}
+
+func (r *DocumentRenderer) DrawPath(
+	commands []PathCommand,
+	options ShapeOptions,
+) error {
+	for _, command := range commands {
+		expected := 1
+		switch command.Kind {
+		case PathCurveTo:
+			expected = 3
+		case PathQuadTo:
+			expected = 2
+		case PathClose:
+			expected = 0
+		}
+
+		if len(command.Points) != expected {
+			err := fmt.Errorf(
+				"DrawPath command %d expects %d points, got %d",
+				command.Kind,
+				expected,
+				len(command.Points),
+			)
+			return ErrInvalidArgument.Wrap(err)
+		}
+	}
+
+	return r.drawShape(flattenPath(commands), options)
+}
+
+func (r *DocumentRenderer) drawShape(
+	subpaths []shapeSubpath,
+	options ShapeOptions,
+) error {
+	if options.Fill == nil && options.Stroke == nil {
+		options.Stroke = &LineOptions{
+			Style: LineStyleSolid,
+			Color: &Color{0, 0, 0},
+		}
+	}
+
+	if options.Opacity > 0 && options.Opacity < 1 {
+		err := r.engine.SetTransparency(gopdf.Transparency{
+			Alpha:         options.Opacity,
+			BlendModeType: gopdf.NormalBlendMode,
+		})
+		if err != nil {
+			return err
+		}
+		defer r.engine.ClearTransparency()
+	}
+
+	var fill *Color
+	switch paint := options.Fill.(type) {
+	case nil:
+	case Color:
+		fill = &paint
+	case *Color:
+		fill = paint
+	default:
+		if err := paint.fill(r, subpaths, options.Opacity); err != nil {
+			return err
+		}
+
+		if options.Stroke == nil {
+			return nil
+		}
+	}
+
+	style := ""
+	if fill != nil {
+		style += "F"
+		r.setFillColor(*fill, true)
+		defer r.SetFillColor(r.currentState.FillColor)
+	}
+
+	if stroke := options.Stroke; stroke != nil {
+		style += "D"
+		r.setStrokeWidth(stroke.StrokeWidth, true)
+		defer r.SetStrokeWidth(r.currentState.StrokeWidth)
+
+		if stroke.Color != nil {
+			r.setStrokeColor(*stroke.Color, true)
+			defer r.SetStrokeColor(r.currentState.StrokeColor)
+		}
+
+		lineStyle := stroke.Style
+		if !lineStyle.IsValid() {
+			lineStyle = LineStyleSolid
+		}
+		r.setLineStyle(lineStyle, true)
+		defer r.SetLineStyle(r.currentState.LineStyle)
+	}
+
+	origin := r.GetCurrentOffset()
+	for _, subpath := range subpaths {
+		points := make([]gopdf.Point, len(subpath.Points))
+		for index, point := range subpath.Points {
+			points[index] = gopdf.Point{X: origin.X + point.X, Y: origin.Y + point.Y}
+		}
+
+		if subpath.Closed {
+			r.engine.Polygon(points, style)
+			continue
+		}
+
+		if fill != nil {
+			r.engine.Polygon(points, "F")
+		}
+
+		if options.Stroke != nil {
+			for index := 1; index < len(points); index++ {
+				r.engine.Line(
+					points[index-1].X,
+					points[index-1].Y,
+					points[index].X,
+					points[index].Y,
+				)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (r *DocumentRenderer) drawShading(
+	box shadingBox,
+	subpaths []shapeSubpath,
+	shading string,
+	opacity float64,
+) (err error) {
+	document := shadingDocument(box, subpaths, shading, opacity)
+	template, ok := r.shadings[string(document)]
+	if !ok {
+		defer func() {
+			if recovered := recover(); recovered != nil {
+				err = ErrElementRender.Wrap(fmt.Errorf("shading import: %v", recovered))
+			}
+		}()
+
+		source := io.ReadSeeker(bytes.NewReader(document))
+		r.shadingSources = append(r.shadingSources, &source)
+		template = r.engine.ImportPageStream(&source, 1, "/MediaBox")
+
+		if r.shadings == nil {
+			r.shadings = map[string]int{}
+		}
+		r.shadings[string(document)] = template
+	}
+
+	origin := r.GetCurrentOffset()
+	r.engine.UseImportedTemplate(
+		template,
+		origin.X+box.Origin.X,
+		origin.Y+box.Origin.Y,
+		box.Size.Width,
+		box.Size.Height,
+	)
+	return nil
+}
+
 func (r *DocumentRenderer) DrawImage(
 	source any,
 	size Size,
@@ -0,0 +1,11 @@
+package grpt
+
+import "testing"
+
+func newTestRenderer(t testing.TB) *DocumentRenderer {
+	t.Helper()
+	return StartNewDocument(RendererOptions{
+		PageSize: PageSizeA4,
+		Padding:  NewEdgeInsets(20, 20, 20, 20),
+	})
+}
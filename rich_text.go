@@ -0,0 +1,104 @@
+package grpt
+
+import (
+	"fmt"
+	"strings"
+)
+
+type TextSpan struct {
+	Text          string
+	Font          *Font
+	Color         *Color
+	Underline     bool
+	Strikethrough bool
+	Link          string
+}
+
+type RichTextRun struct {
+	Span  *TextSpan
+	Font  Font
+	Text  string
+	Width float64
+}
+
+type RichTextLine struct {
+	Runs   []RichTextRun
+	Width  float64
+	Height float64
+	Ascent float64
+}
+
+type RichText struct {
+	Size  Size
+	Style TextStyle
+	Spans []TextSpan
+
+	wasMeasuredAtLeastOnce bool
+	originalSize           Size
+}
+
+func NewRichText(spans ...TextSpan) *RichText {
+	return &RichText{Spans: spans}
+}
+
+func (t RichText) GetSize() Size {
+	return t.Size
+}
+
+func (t *RichText) Measure(boundries Size, renderer *DocumentRenderer) {
+	if t.wasMeasuredAtLeastOnce {
+		t.Size = t.originalSize
+	} else {
+		t.originalSize = t.Size
+	}
+	t.wasMeasuredAtLeastOnce = true
+
+	t.Size = t.Size.Merge(boundries)
+	if t.Size.HasZeroValue() {
+		size, _ := renderer.MeasureRichText(t.Spans, &t.Style, t.Size)
+		t.Size = t.Size.Merge(size)
+	}
+}
+
+func (t *RichText) Render(renderer *DocumentRenderer) error {
+	if t.Size.HasZeroValue() {
+		return fmt.Errorf(
+			"RichText size cannot have width or height of 0: {w:%v, h:%v}: %w",
+			t.Size.Width,
+			t.Size.Height,
+			ErrInvalidSize,
+		)
+	}
+
+	return renderer.DrawRichText(t.Spans, t.Size, &t.Style)
+}
+
+func splitRichTextTokens(text string) []string {
+	var tokens []string
+	var token strings.Builder
+	for _, char := range text {
+		switch {
+		case char == '\n':
+			if token.Len() > 0 {
+				tokens = append(tokens, token.String())
+				token.Reset()
+			}
+			tokens = append(tokens, "\n")
+		case char == ' ' || char == '\t':
+			token.WriteRune(char)
+		default:
+			current := token.String()
+			if len(current) > 0 && strings.TrimRight(current, " \t") != current {
+				tokens = append(tokens, current)
+				token.Reset()
+			}
+			token.WriteRune(char)
+		}
+	}
+
+	if token.Len() > 0 {
+		tokens = append(tokens, token.String())
+	}
+
+	return tokens
+}
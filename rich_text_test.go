@@ -0,0 +1,72 @@
+package grpt
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestSplitRichTextTrailingSpace(t *testing.T) {
+	renderer := newTestRenderer(t)
+	font := renderer.currentState.Font
+	exact, err := renderer.measureTextWithFont("alpha beta", font)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name  string
+		width float64
+		want  []string
+	}{
+		{"fits without the trailing space", exact, []string{"alpha beta"}},
+		{"wraps when narrower", exact - 1, []string{"alpha", "beta"}},
+		{"spaces at the break are dropped", exact + 100, []string{"alpha beta"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			spans := []TextSpan{{Text: "alpha beta "}}
+			lines, err := renderer.SplitRichText(spans, NewWidth(test.width), &TextStyle{})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var got []string
+			for _, line := range lines {
+				var text string
+				for _, run := range line.Runs {
+					text += run.Text
+				}
+				got = append(got, text)
+			}
+
+			if len(got) != len(test.want) {
+				t.Fatalf("lines = %q, want %q", got, test.want)
+			}
+			for index := range got {
+				if got[index] != test.want[index] {
+					t.Fatalf("lines = %q, want %q", got, test.want)
+				}
+			}
+		})
+	}
+}
+
+func TestZeroSizeTextRender(t *testing.T) {
+	tests := []struct {
+		name    string
+		element Element
+	}{
+		{"text", &Text{Value: "text", Size: NewWidth(100)}},
+		{"rich text", &RichText{Spans: []TextSpan{{Text: "text"}}, Size: NewWidth(100)}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.element.Render(newTestRenderer(t))
+			if !errors.Is(err, ErrInvalidSize) {
+				t.Fatalf("err = %v, want ErrInvalidSize", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,123 @@
+package grpt
+
+import "math"
+
+type Rotated struct {
+	Angle  float64
+	Origin Alignment
+	Child  Element
+
+	size Size
+}
+
+func NewRotated(angle float64, child Element) *Rotated {
+	return &Rotated{Angle: angle, Child: child}
+}
+
+func NewRotatedAround(angle float64, origin Alignment, child Element) *Rotated {
+	return &Rotated{Angle: angle, Origin: origin, Child: child}
+}
+
+func NewVertical(child Element) *Rotated {
+	return NewRotated(90, child)
+}
+
+func (r Rotated) GetSize() Size {
+	return r.size
+}
+
+func (r *Rotated) Measure(boundries Size, renderer *DocumentRenderer) {
+	if r.Child == nil {
+		r.size = NewSize(0, 0)
+		return
+	}
+
+	inner := boundries
+	if r.isQuarterTurn() {
+		inner = NewSize(boundries.Height, boundries.Width)
+	}
+
+	measureLoosely(r.Child, inner, renderer)
+	r.size = r.rotatedSize(r.Child.GetSize())
+}
+
+func (r *Rotated) Render(renderer *DocumentRenderer) error {
+	defer renderer.SetOffset(renderer.GetCurrentOffset())
+	if r.Child == nil {
+		return nil
+	}
+
+	pivot, childOffset := r.placement(renderer.GetCurrentOffset())
+	renderer.SetOffset(childOffset)
+
+	if math.Mod(r.Angle, 360) == 0 {
+		return r.Child.Render(renderer)
+	}
+
+	renderer.engine.Rotate(r.Angle, pivot.X, pivot.Y)
+	defer renderer.engine.RotateReset()
+
+	return r.Child.Render(renderer)
+}
+
+func (r *Rotated) placement(offset Offset) (Offset, Offset) {
+	origin := r.Origin
+	if origin == 0 {
+		origin = CenterAlignment
+	}
+
+	boxPoint := origin.Offset(r.size, Size{})
+	pivot := NewOffset(offset.X+boxPoint.X, offset.Y+boxPoint.Y)
+
+	childSize := r.Child.GetSize()
+	corner := NewOffset(math.Inf(1), math.Inf(1))
+	for _, point := range []Offset{
+		NewOffset(0, 0),
+		NewOffset(childSize.Width, 0),
+		NewOffset(childSize.Width, childSize.Height),
+		NewOffset(0, childSize.Height),
+	} {
+		rotated := r.rotate(point, r.Angle)
+		corner = NewOffset(min(corner.X, rotated.X), min(corner.Y, rotated.Y))
+	}
+
+	child := r.rotate(
+		NewOffset(offset.X-pivot.X-corner.X, offset.Y-pivot.Y-corner.Y),
+		-r.Angle,
+	)
+
+	return pivot, NewOffset(pivot.X+child.X, pivot.Y+child.Y)
+}
+
+func (r *Rotated) rotate(point Offset, angle float64) Offset {
+	sin, cos := math.Sincos(angle * math.Pi / 180)
+	if math.Mod(angle, 90) == 0 {
+		sin, cos = math.Round(sin), math.Round(cos)
+	}
+
+	return NewOffset(
+		point.X*cos+point.Y*sin,
+		point.Y*cos-point.X*sin,
+	)
+}
+
+func (r *Rotated) isQuarterTurn() bool {
+	return math.Abs(math.Mod(r.Angle, 180)) == 90
+}
+
+func (r *Rotated) rotatedSize(size Size) Size {
+	radians := r.Angle * math.Pi / 180
+	cos := math.Abs(math.Cos(radians))
+	sin := math.Abs(math.Sin(radians))
+
+	if r.isQuarterTurn() {
+		cos, sin = 0, 1
+	} else if math.Mod(r.Angle, 180) == 0 {
+		cos, sin = 1, 0
+	}
+
+	return NewSize(
+		size.Width*cos+size.Height*sin,
+		size.Width*sin+size.Height*cos,
+	)
+}
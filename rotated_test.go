@@ -0,0 +1,61 @@
+package grpt
+
+import (
+	"math"
+	"testing"
+)
+
+func TestRotatedPlacement(t *testing.T) {
+	tests := []struct {
+		name   string
+		angle  float64
+		origin Alignment
+	}{
+		{name: "centre by default", angle: 90},
+		{name: "top left corner", angle: 90, origin: TopAlignment | LeftAlignment},
+		{name: "bottom right corner", angle: 90, origin: BottomAlignment | RightAlignment},
+		{name: "top right corner upside down", angle: 180, origin: TopAlignment | RightAlignment},
+		{name: "bottom left corner", angle: 270, origin: BottomAlignment | LeftAlignment},
+		{name: "top left corner at an angle", angle: 30, origin: TopAlignment | LeftAlignment},
+		{name: "negative angle", angle: -45, origin: BottomAlignment | RightAlignment},
+		{name: "without rotation", origin: BottomAlignment | LeftAlignment},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			rotated := NewRotatedAround(
+				test.angle,
+				test.origin,
+				&Container{Size: NewSize(100, 20), Child: NewVerticalSpace(20)},
+			)
+			rotated.Measure(NewSize(0, 0), newTestRenderer(t))
+
+			offset := NewOffset(30, 40)
+			pivot, child := rotated.placement(offset)
+
+			sin, cos := math.Sincos(test.angle * math.Pi / 180)
+			minimum := NewOffset(math.Inf(1), math.Inf(1))
+			maximum := NewOffset(math.Inf(-1), math.Inf(-1))
+			for _, corner := range []Offset{
+				NewOffset(child.X, child.Y),
+				NewOffset(child.X+100, child.Y),
+				NewOffset(child.X+100, child.Y+20),
+				NewOffset(child.X, child.Y+20),
+			} {
+				x, y := corner.X-pivot.X, corner.Y-pivot.Y
+				point := NewOffset(pivot.X+x*cos+y*sin, pivot.Y+y*cos-x*sin)
+				minimum = NewOffset(min(minimum.X, point.X), min(minimum.Y, point.Y))
+				maximum = NewOffset(max(maximum.X, point.X), max(maximum.Y, point.Y))
+			}
+
+			size := rotated.GetSize()
+			want := []float64{offset.X, offset.Y, offset.X + size.Width, offset.Y + size.Height}
+			got := []float64{minimum.X, minimum.Y, maximum.X, maximum.Y}
+			for index := range want {
+				if math.Abs(got[index]-want[index]) > 1e-6 {
+					t.Fatalf("rotated child bounds %v, want %v", got, want)
+				}
+			}
+		})
+	}
+}
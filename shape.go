@@ -0,0 +1,441 @@
+package grpt
+
+import "math"
+
+const (
+	bezierCircleKappa = 0.5522847498
+	minCurveSegments  = 4
+	maxCurveSegments  = 64
+)
+
+type ShapeOptions struct {
+	Fill    Paint
+	Stroke  *LineOptions
+	Opacity float64
+}
+
+type CornerRadius struct {
+	TopLeft     float64
+	TopRight    float64
+	BottomRight float64
+	BottomLeft  float64
+}
+
+func NewCornerRadius(radius float64) CornerRadius {
+	return CornerRadius{
+		TopLeft:     radius,
+		TopRight:    radius,
+		BottomRight: radius,
+		BottomLeft:  radius,
+	}
+}
+
+func (c CornerRadius) IsZero() bool {
+	return c.TopLeft == 0 &&
+		c.TopRight == 0 &&
+		c.BottomRight == 0 &&
+		c.BottomLeft == 0
+}
+
+func (c CornerRadius) clamp(size Size) CornerRadius {
+	limit := min(size.Width, size.Height) / 2
+	return CornerRadius{
+		TopLeft:     max(0, min(c.TopLeft, limit)),
+		TopRight:    max(0, min(c.TopRight, limit)),
+		BottomRight: max(0, min(c.BottomRight, limit)),
+		BottomLeft:  max(0, min(c.BottomLeft, limit)),
+	}
+}
+
+type PathCommandKind int
+
+const (
+	PathMoveTo PathCommandKind = iota
+	PathLineTo
+	PathCurveTo
+	PathQuadTo
+	PathClose
+)
+
+type PathCommand struct {
+	Kind   PathCommandKind
+	Points []Offset
+}
+
+type shapeSubpath struct {
+	Points []Offset
+	Closed bool
+}
+
+type Rectangle struct {
+	Size         Size
+	CornerRadius CornerRadius
+	Options      ShapeOptions
+}
+
+func NewRectangle(size Size, options ShapeOptions) *Rectangle {
+	return &Rectangle{
+		Size:    size,
+		Options: options,
+	}
+}
+
+func NewRoundedRectangle(
+	size Size,
+	radius CornerRadius,
+	options ShapeOptions,
+) *Rectangle {
+	return &Rectangle{
+		Size:         size,
+		CornerRadius: radius,
+		Options:      options,
+	}
+}
+
+func (r Rectangle) GetSize() Size {
+	return r.Size
+}
+
+func (r *Rectangle) Measure(boundries Size, renderer *DocumentRenderer) {
+	r.Size = r.Size.Merge(boundries)
+}
+
+func (r *Rectangle) Render(renderer *DocumentRenderer) error {
+	defer renderer.SetOffset(renderer.GetCurrentOffset())
+	return renderer.DrawRectangle(r.Size, r.CornerRadius, r.Options)
+}
+
+type Ellipse struct {
+	Size    Size
+	Options ShapeOptions
+}
+
+func NewEllipse(size Size, options ShapeOptions) *Ellipse {
+	return &Ellipse{
+		Size:    size,
+		Options: options,
+	}
+}
+
+func NewCircle(diameter float64, options ShapeOptions) *Ellipse {
+	return NewEllipse(NewSquareSize(diameter), options)
+}
+
+func (e Ellipse) GetSize() Size {
+	return e.Size
+}
+
+func (e *Ellipse) Measure(boundries Size, renderer *DocumentRenderer) {
+	e.Size = e.Size.Merge(boundries)
+}
+
+func (e *Ellipse) Render(renderer *DocumentRenderer) error {
+	defer renderer.SetOffset(renderer.GetCurrentOffset())
+	return renderer.DrawEllipse(e.Size, e.Options)
+}
+
+type Polygon struct {
+	Size    Size
+	Points  []Offset
+	Options ShapeOptions
+}
+
+func NewPolygon(points []Offset, options ShapeOptions) *Polygon {
+	return &Polygon{
+		Points:  points,
+		Options: options,
+	}
+}
+
+func (p Polygon) GetSize() Size {
+	return p.Size
+}
+
+func (p *Polygon) Measure(boundries Size, renderer *DocumentRenderer) {
+	p.Size = p.Size.Merge(shapeBounds(p.Points))
+}
+
+func (p *Polygon) Render(renderer *DocumentRenderer) error {
+	defer renderer.SetOffset(renderer.GetCurrentOffset())
+	return renderer.DrawPolygon(p.Points, p.Options)
+}
+
+type Path struct {
+	Size     Size
+	Commands []PathCommand
+	Options  ShapeOptions
+}
+
+func NewPath(options ShapeOptions) *Path {
+	return &Path{Options: options}
+}
+
+func (p *Path) MoveTo(x, y float64) *Path {
+	return p.add(PathMoveTo, NewOffset(x, y))
+}
+
+func (p *Path) LineTo(x, y float64) *Path {
+	return p.add(PathLineTo, NewOffset(x, y))
+}
+
+func (p *Path) CurveTo(x1, y1, x2, y2, x, y float64) *Path {
+	return p.add(
+		PathCurveTo,
+		NewOffset(x1, y1),
+		NewOffset(x2, y2),
+		NewOffset(x, y),
+	)
+}
+
+func (p *Path) QuadTo(x1, y1, x, y float64) *Path {
+	return p.add(PathQuadTo, NewOffset(x1, y1), NewOffset(x, y))
+}
+
+func (p *Path) Close() *Path {
+	return p.add(PathClose)
+}
+
+func (p *Path) add(kind PathCommandKind, points ...Offset) *Path {
+	p.Commands = append(p.Commands, PathCommand{Kind: kind, Points: points})
+	return p
+}
+
+func (p Path) GetSize() Size {
+	return p.Size
+}
+
+func (p *Path) Measure(boundries Size, renderer *DocumentRenderer) {
+	var points []Offset
+	for _, command := range p.Commands {
+		points = append(points, command.Points...)
+	}
+	p.Size = p.Size.Merge(shapeBounds(points))
+}
+
+func (p *Path) Render(renderer *DocumentRenderer) error {
+	defer renderer.SetOffset(renderer.GetCurrentOffset())
+	return renderer.DrawPath(p.Commands, p.Options)
+}
+
+func shapeBounds(points []Offset) Size {
+	var size Size
+	for _, point := range points {
+		size.Width = max(size.Width, point.X)
+		size.Height = max(size.Height, point.Y)
+	}
+	return size
+}
+
+func rectanglePath(size Size, radius CornerRadius) []shapeSubpath {
+	radius = radius.clamp(size)
+	width, height := size.Width, size.Height
+
+	path := &Path{}
+	path.MoveTo(radius.TopLeft, 0)
+	path.LineTo(width-radius.TopRight, 0)
+	appendCorner(path, width-radius.TopRight, radius.TopRight, radius.TopRight, -90)
+	path.LineTo(width, height-radius.BottomRight)
+	appendCorner(path, width-radius.BottomRight, height-radius.BottomRight, radius.BottomRight, 0)
+	path.LineTo(radius.BottomLeft, height)
+	appendCorner(path, radius.BottomLeft, height-radius.BottomLeft, radius.BottomLeft, 90)
+	path.LineTo(0, radius.TopLeft)
+	appendCorner(path, radius.TopLeft, radius.TopLeft, radius.TopLeft, 180)
+	path.Close()
+
+	return flattenPath(path.Commands)
+}
+
+func appendCorner(path *Path, cx, cy, radius, startAngle float64) {
+	if radius <= 0 {
+		return
+	}
+	appendArc(path, cx, cy, radius, radius, startAngle, 90)
+}
+
+func appendArc(path *Path, cx, cy, rx, ry, startAngle, sweep float64) {
+	start := startAngle * math.Pi / 180
+	end := (startAngle + sweep) * math.Pi / 180
+
+	kappa := bezierCircleKappa
+	if sweep != 90 {
+		kappa = 4.0 / 3 * math.Tan((end-start)/4)
+	}
+
+	x0, y0 := math.Cos(start), math.Sin(start)
+	x3, y3 := math.Cos(end), math.Sin(end)
+	path.CurveTo(
+		cx+rx*(x0-kappa*y0), cy+ry*(y0+kappa*x0),
+		cx+rx*(x3+kappa*y3), cy+ry*(y3-kappa*x3),
+		cx+rx*x3, cy+ry*y3,
+	)
+}
+
+func roundedBorderPath(
+	size Size,
+	radius CornerRadius,
+	side BorderSide,
+) []PathCommand {
+	radius = radius.clamp(size)
+	width, height := size.Width, size.Height
+
+	type corner struct {
+		cx, cy, radius float64
+	}
+
+	topLeft := corner{radius.TopLeft, radius.TopLeft, radius.TopLeft}
+	topRight := corner{width - radius.TopRight, radius.TopRight, radius.TopRight}
+	bottomRight := corner{
+		width - radius.BottomRight,
+		height - radius.BottomRight,
+		radius.BottomRight,
+	}
+	bottomLeft := corner{radius.BottomLeft, height - radius.BottomLeft, radius.BottomLeft}
+
+	edges := []struct {
+		side        BorderSide
+		first, last corner
+		angle       float64
+	}{
+		{BorderTop, topLeft, topRight, 225},
+		{BorderRigh, topRight, bottomRight, 315},
+		{BorderBottom, bottomRight, bottomLeft, 45},
+		{BorderLeft, bottomLeft, topLeft, 135},
+	}
+
+	path := &Path{}
+	for _, edge := range edges {
+		if side&edge.side == 0 {
+			continue
+		}
+
+		start := edge.angle * math.Pi / 180
+		path.MoveTo(
+			edge.first.cx+edge.first.radius*math.Cos(start),
+			edge.first.cy+edge.first.radius*math.Sin(start),
+		)
+		if edge.first.radius > 0 {
+			appendArc(
+				path,
+				edge.first.cx,
+				edge.first.cy,
+				edge.first.radius,
+				edge.first.radius,
+				edge.angle,
+				45,
+			)
+		}
+
+		end := (edge.angle + 45) * math.Pi / 180
+		path.LineTo(
+			edge.last.cx+edge.last.radius*math.Cos(end),
+			edge.last.cy+edge.last.radius*math.Sin(end),
+		)
+		if edge.last.radius > 0 {
+			appendArc(
+				path,
+				edge.last.cx,
+				edge.last.cy,
+				edge.last.radius,
+				edge.last.radius,
+				edge.angle+45,
+				45,
+			)
+		}
+	}
+
+	return path.Commands
+}
+
+func ellipsePath(size Size) []shapeSubpath {
+	rx, ry := size.Width/2, size.Height/2
+
+	path := &Path{}
+	path.MoveTo(size.Width, ry)
+	for _, angle := range []float64{0, 90, 180, 270} {
+		appendArc(path, rx, ry, rx, ry, angle, 90)
+	}
+	path.Close()
+
+	return flattenPath(path.Commands)
+}
+
+func flattenPath(commands []PathCommand) []shapeSubpath {
+	var subpaths []shapeSubpath
+	var current shapeSubpath
+	var cursor, start Offset
+
+	flush := func() {
+		if len(current.Points) > 1 {
+			subpaths = append(subpaths, current)
+		}
+		current = shapeSubpath{}
+	}
+
+	for _, command := range commands {
+		switch command.Kind {
+		case PathMoveTo:
+			flush()
+			cursor = command.Points[0]
+			start = cursor
+			current.Points = []Offset{cursor}
+		case PathLineTo:
+			if len(current.Points) == 0 {
+				current.Points = []Offset{cursor}
+			}
+			cursor = command.Points[0]
+			current.Points = append(current.Points, cursor)
+		case PathCurveTo, PathQuadTo:
+			if len(current.Points) == 0 {
+				current.Points = []Offset{cursor}
+			}
+
+			control1, control2, end := command.Points[0], command.Points[0], command.Points[1]
+			if command.Kind == PathCurveTo {
+				control2, end = command.Points[1], command.Points[2]
+			} else {
+				control1 = NewOffset(
+					cursor.X+2.0/3*(command.Points[0].X-cursor.X),
+					cursor.Y+2.0/3*(command.Points[0].Y-cursor.Y),
+				)
+				control2 = NewOffset(
+					end.X+2.0/3*(command.Points[0].X-end.X),
+					end.Y+2.0/3*(command.Points[0].Y-end.Y),
+				)
+			}
+
+			current.Points = append(
+				current.Points,
+				flattenCubic(cursor, control1, control2, end)...,
+			)
+			cursor = end
+		case PathClose:
+			current.Closed = true
+			flush()
+			cursor = start
+		}
+	}
+	flush()
+
+	return subpaths
+}
+
+func flattenCubic(p0, p1, p2, p3 Offset) []Offset {
+	length := math.Hypot(p1.X-p0.X, p1.Y-p0.Y) +
+		math.Hypot(p2.X-p1.X, p2.Y-p1.Y) +
+		math.Hypot(p3.X-p2.X, p3.Y-p2.Y)
+
+	segments := int(math.Ceil(math.Sqrt(length) * 2))
+	segments = max(minCurveSegments, min(segments, maxCurveSegments))
+
+	points := make([]Offset, segments)
+	for index := range segments {
+		t := float64(index+1) / float64(segments)
+		u := 1 - t
+		a, b, c, d := u*u*u, 3*u*u*t, 3*u*t*t, t*t*t
+		points[index] = NewOffset(
+			a*p0.X+b*p1.X+c*p2.X+d*p3.X,
+			a*p0.Y+b*p1.Y+c*p2.Y+d*p3.Y,
+		)
+	}
+	return points
+}
@@ -0,0 +1,106 @@
+package grpt
+
+import (
+	"math"
+	"testing"
+)
+
+func TestFlattenPath(t *testing.T) {
+	tests := []struct {
+		name     string
+		path     *Path
+		subpaths int
+		closed   bool
+		last     Offset
+	}{
+		{
+			name:     "open polyline",
+			path:     NewPath(ShapeOptions{}).MoveTo(0, 0).LineTo(10, 0).LineTo(10, 10),
+			subpaths: 1,
+			last:     NewOffset(10, 10),
+		},
+		{
+			name:     "closed curve",
+			path:     NewPath(ShapeOptions{}).MoveTo(0, 0).CurveTo(0, 10, 10, 10, 10, 0).Close(),
+			subpaths: 1,
+			closed:   true,
+			last:     NewOffset(10, 0),
+		},
+		{
+			name:     "quadratic curve",
+			path:     NewPath(ShapeOptions{}).MoveTo(0, 0).QuadTo(5, 10, 10, 0),
+			subpaths: 1,
+			last:     NewOffset(10, 0),
+		},
+		{
+			name: "two subpaths",
+			path: NewPath(ShapeOptions{}).
+				MoveTo(0, 0).LineTo(5, 5).
+				MoveTo(10, 10).LineTo(20, 20),
+			subpaths: 2,
+			last:     NewOffset(20, 20),
+		},
+		{
+			name:     "lone move is dropped",
+			path:     NewPath(ShapeOptions{}).MoveTo(0, 0).MoveTo(5, 5).LineTo(6, 6),
+			subpaths: 1,
+			last:     NewOffset(6, 6),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			subpaths := flattenPath(test.path.Commands)
+			if len(subpaths) != test.subpaths {
+				t.Fatalf("expected %d subpaths, got %d", test.subpaths, len(subpaths))
+			}
+
+			last := subpaths[len(subpaths)-1]
+			if last.Closed != test.closed {
+				t.Fatalf("expected closed = %v", test.closed)
+			}
+
+			point := last.Points[len(last.Points)-1]
+			if math.Abs(point.X-test.last.X) > 1e-9 || math.Abs(point.Y-test.last.Y) > 1e-9 {
+				t.Fatalf("expected the path to end at %v, got %v", test.last, point)
+			}
+		})
+	}
+}
+
+func TestShapeBounds(t *testing.T) {
+	tests := []struct {
+		name     string
+		subpaths []shapeSubpath
+		size     Size
+	}{
+		{
+			name:     "rectangle",
+			subpaths: rectanglePath(NewSize(100, 40), CornerRadius{}),
+			size:     NewSize(100, 40),
+		},
+		{
+			name:     "radius is clamped",
+			subpaths: rectanglePath(NewSize(100, 40), NewCornerRadius(50)),
+			size:     NewSize(100, 40),
+		},
+		{
+			name:     "ellipse",
+			subpaths: ellipsePath(NewSize(60, 30)),
+			size:     NewSize(60, 30),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			origin, size := subpathBounds(test.subpaths)
+			if math.Abs(origin.X) > 1e-6 || math.Abs(origin.Y) > 1e-6 {
+				t.Fatalf("expected the shape to start at the origin, got %v", origin)
+			}
+			if math.Abs(size.Width-test.size.Width) > 1e-6 ||
+				math.Abs(size.Height-test.size.Height) > 1e-6 {
+				t.Fatalf("expected size %v, got %v", test.size, size)
+			}
+		})
+	}
+}
@@ -9,6 +9,8 @@ import (
 
 const MaxSize float64 = math.MaxFloat32
 
+const sizeTolerance float64 = 1e-6
+
 type Size struct {
 	Width  float64
 	Height float64
@@ -18,14 +18,46 @@ const (
 	TextTypePercentage
 )
 
+type TextFit int
+
+const (
+	TextFitNone TextFit = iota
+	TextFitShrink
+)
+
+const (
+	defaultMinFontSize  float64 = 4
+	defaultFontSizeStep float64 = 0.5
+	defaultTextOverflow string  = "…"
+)
+
 type TextStyle struct {
-	Font      *Font
-	Alignment Alignment
-	Borders   []Border
-	Padding   EdgeInsets
-	WordWrap  bool
-	Multiline bool
-	Overflow  string
+	Font            *Font
+	Color           *Color
+	BackgroundColor *Color
+	Background      Paint
+	Strikethrough   bool
+	Alignment       Alignment
+	Direction       TextDirection
+	Borders         []Border
+	Padding         EdgeInsets
+	WordWrap        bool
+	Multiline       bool
+	Overflow        string
+	Hyphenation     string
+	Fit             TextFit
+	MinFontSize     float64
+	FontSizeStep    float64
+	Orphans         int
+	Widows          int
+
+	LineHeight             float64
+	LineSpacing            float64
+	LetterSpacing          float64
+	ParagraphSpacingBefore float64
+	ParagraphSpacingAfter  float64
+	FirstLineIndent        float64
+	HangingIndent          float64
 }
 
 func (t TextStyle) Merge(other TextStyle) TextStyle {
@@ -35,6 +67,22 @@ func (t TextStyle) Merge(other TextStyle) TextStyle {
 		t.Font = other.Font
 	}
 
+	if t.Color == nil {
+		t.Color = other.Color
+	}
+
+	if t.BackgroundColor == nil {
+		t.BackgroundColor = other.BackgroundColor
+	}
+
+	if t.Background == nil {
+		t.Background = other.Background
+	}
+
+	if !t.Strikethrough {
+		t.Strikethrough = other.Strikethrough
+	}
+
 	if t.Padding.IsZero() {
 		t.Padding = other.Padding
 	}
@@ -47,6 +95,26 @@ func (t TextStyle) Merge(other TextStyle) TextStyle {
 		t.Overflow = other.Overflow
 	}
 
+	if t.Direction == TextDirectionAuto {
+		t.Direction = other.Direction
+	}
+
+	if len(t.Hyphenation) == 0 {
+		t.Hyphenation = other.Hyphenation
+	}
+
+	if t.Fit == TextFitNone {
+		t.Fit = other.Fit
+	}
+
+	if t.MinFontSize == 0 {
+		t.MinFontSize = other.MinFontSize
+	}
+
+	if t.FontSizeStep == 0 {
+		t.FontSizeStep = other.FontSizeStep
+	}
+
 	if !t.WordWrap {
 		t.WordWrap = other.WordWrap
 	}
@@ -55,6 +123,42 @@ func (t TextStyle) Merge(other TextStyle) TextStyle {
 		t.Multiline = other.Multiline
 	}
 
+	if t.Orphans == 0 {
+		t.Orphans = other.Orphans
+	}
+
+	if t.Widows == 0 {
+		t.Widows = other.Widows
+	}
+
+	if t.LineHeight == 0 {
+		t.LineHeight = other.LineHeight
+	}
+
+	if t.LineSpacing == 0 {
+		t.LineSpacing = other.LineSpacing
+	}
+
+	if t.LetterSpacing == 0 {
+		t.LetterSpacing = other.LetterSpacing
+	}
+
+	if t.ParagraphSpacingBefore == 0 {
+		t.ParagraphSpacingBefore = other.ParagraphSpacingBefore
+	}
+
+	if t.ParagraphSpacingAfter == 0 {
+		t.ParagraphSpacingAfter = other.ParagraphSpacingAfter
+	}
+
+	if t.FirstLineIndent == 0 {
+		t.FirstLineIndent = other.FirstLineIndent
+	}
+
+	if t.HangingIndent == 0 {
+		t.HangingIndent = other.HangingIndent
+	}
+
 	return t
 }
 
@@ -95,12 +199,12 @@ func (t *Text) Measure(boundries Size, renderer *DocumentRenderer) {
 
 func (t *Text) Render(renderer *DocumentRenderer) error {
 	if t.Size.HasZeroValue() {
-		panic(fmt.Errorf(
+		return fmt.Errorf(
 			"Text size cannot have width or height of 0: {w:%v, h:%v}: %w",
 			t.Size.Width,
 			t.Size.Height,
 			ErrInvalidSize,
-		))
+		)
 	}
 
 	if !t.wasMeasuredAtLeastOnce {
@@ -110,6 +214,75 @@ func (t *Text) Render(renderer *DocumentRenderer) error {
 	return renderer.DrawText(t.text, t.Size, &t.Style)
 }
 
+func (t *Text) Baseline(renderer *DocumentRenderer) float64 {
+	ascent, descent := renderer.MeasureFontAscent(t.Style.Font)
+	padding := t.Style.Padding
+	height := t.Size.Height - padding.Top - padding.Bottom
+
+	return padding.Top + textBaseline(t.Style.Alignment, height, ascent, descent)
+}
+
+func (t *Text) Split(
+	height float64,
+	renderer *DocumentRenderer,
+) (Element, Element, bool) {
+	if !t.Style.Multiline {
+		return nil, nil, false
+	}
+
+	if !t.wasMeasuredAtLeastOnce {
+		t.text = t.parseValue()
+	}
+
+	lines, err := renderer.SplitTextLines(t.text, t.Size.Width, &t.Style)
+	if err != nil || len(lines) == 0 {
+		return nil, nil, false
+	}
+
+	verticalPadding := t.Style.Padding.Top + t.Style.Padding.Bottom
+	lines = lines[:FitTextLines(lines, t.Size.Height-verticalPadding)]
+
+	fitting := FitTextLines(lines, height-verticalPadding)
+	if fitting >= len(lines) {
+		return t, nil, true
+	}
+
+	if len(lines)-fitting < t.Style.Widows {
+		fitting = len(lines) - t.Style.Widows
+	}
+
+	if fitting <= 0 || fitting < t.Style.Orphans {
+		return nil, nil, false
+	}
+
+	head := t.fragment(lines[:fitting])
+	tail := t.fragment(lines[fitting:])
+	if !lines[fitting].ParagraphStart {
+		tail.Style.FirstLineIndent = t.Style.HangingIndent
+	}
+
+	return head, tail, true
+}
+
+func (t *Text) fragment(lines []TextLine) *Text {
+	last := lines[len(lines)-1]
+	text := t.text[lines[0].SourceStart:last.SourceEnd]
+	if last.Hyphenated {
+		text += "-"
+	}
+
+	verticalPadding := t.Style.Padding.Top + t.Style.Padding.Bottom
+	return &Text{
+		Value:          text,
+		SkipFormatting: true,
+		Size: NewSize(
+			t.Size.Width,
+			TextBlockHeight(lines)+verticalPadding,
+		),
+		Style: t.Style,
+	}
+}
+
 func (t *Text) parseValue() string {
 	if !t.SkipFormatting {
 		if t.Formatter != nil {
@@ -0,0 +1,112 @@
+package grpt
+
+import (
+	"slices"
+	"strings"
+	"testing"
+	"unicode"
+)
+
+func TestTextSplitKeepsWords(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		style TextStyle
+	}{
+		{
+			name:  "word wrap",
+			value: "alpha beta gamma delta epsilon zeta eta theta",
+		},
+		{
+			name:  "paragraphs",
+			value: "alpha beta gamma\ndelta epsilon zeta eta theta",
+		},
+		{
+			name:  "hanging indent",
+			value: "alpha beta gamma delta epsilon zeta eta theta",
+			style: TextStyle{FirstLineIndent: 10},
+		},
+		{
+			name:  "font fallbacks",
+			value: "alpha beta gamma ✓ delta epsilon zeta eta theta",
+			style: TextStyle{Font: &Font{Fallbacks: []string{"roboto"}}},
+		},
+		{
+			name:  "hyphenation",
+			value: "hyphenation typography hyphenation typography",
+			style: TextStyle{Hyphenation: "en-us"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			renderer := newTestRenderer(t)
+			style := test.style
+			style.Multiline = true
+			style.WordWrap = true
+
+			text := &Text{Value: test.value, Style: style}
+			text.Measure(NewWidth(70), renderer)
+
+			lines, err := renderer.SplitTextLines(test.value, text.Size.Width, &text.Style)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(lines) < 3 {
+				t.Fatalf("expected the text to wrap, got %d lines", len(lines))
+			}
+
+			var parts []string
+			var element Element = text
+			for element != nil {
+				head, tail, ok := element.(SplittableElement).Split(lines[0].Height*1.5, renderer)
+				if !ok {
+					t.Fatal("text could not be split")
+				}
+
+				value := head.(*Text).Value.(string)
+				if strings.TrimLeftFunc(value, unicode.IsSpace) != value {
+					t.Fatalf("fragment %q starts with whitespace", value)
+				}
+				parts = append(parts, value)
+				element = tail
+			}
+
+			got := strings.Fields(strings.ReplaceAll(strings.Join(parts, " "), "- ", ""))
+			want := strings.Fields(test.value)
+			if !slices.Equal(got, want) {
+				t.Fatalf("words = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestDrawTextBackgroundErrors(t *testing.T) {
+	color := NewColor(200, 200, 200)
+	tests := []struct {
+		name  string
+		style TextStyle
+		want  string
+	}{
+		{
+			name:  "background color",
+			style: TextStyle{BackgroundColor: &color},
+			want:  "DrawFilledBox",
+		},
+		{
+			name:  "background paint",
+			style: TextStyle{Background: color},
+			want:  "FillRectangle",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			renderer := newTestRenderer(t)
+			err := renderer.DrawText("alpha", NewSize(100, 0), &test.style)
+			if err == nil || !strings.Contains(err.Error(), test.want) {
+				t.Fatalf("expected a %s error, got %v", test.want, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package grpt
+
+const (
+	ThemeParagraph   = "p"
+	ThemeHeading1    = "h1"
+	ThemeHeading2    = "h2"
+	ThemeHeading3    = "h3"
+	ThemeHeading4    = "h4"
+	ThemeHeading5    = "h5"
+	ThemeHeading6    = "h6"
+	ThemeCode        = "code"
+	ThemeCodeBlock   = "pre"
+	ThemeLink        = "a"
+	ThemeListItem    = "li"
+	ThemeTableHeader = "th"
+	ThemeTableCell   = "td"
+)
+
+type TextTheme map[string]TextStyle
+
+func DefaultTextTheme() TextTheme {
+	gray := NewColor(90, 90, 90)
+	blue := NewColor(0, 0, 200)
+
+	return TextTheme{
+		ThemeHeading1:  {Font: &Font{Size: 16, Style: NewFontStyle(true, false, false)}},
+		ThemeHeading2:  {Font: &Font{Size: 14, Style: NewFontStyle(true, false, false)}},
+		ThemeHeading3:  {Font: &Font{Size: 12, Style: NewFontStyle(true, false, false)}},
+		ThemeHeading4:  {Font: &Font{Size: 10, Style: NewFontStyle(true, false, false)}},
+		ThemeHeading5:  {Font: &Font{Style: NewFontStyle(true, false, false)}},
+		ThemeHeading6:  {Font: &Font{Style: NewFontStyle(true, true, false)}},
+		ThemeCode:      {Font: &Font{Family: "roboto"}, Color: &gray},
+		ThemeCodeBlock: {Font: &Font{Family: "roboto"}, Color: &gray, Padding: NewSquareEdgeInsets(4)},
+		ThemeLink:      {Color: &blue},
+		ThemeTableHeader: {
+			Font:    &Font{Style: NewFontStyle(true, false, false)},
+			Borders: []Border{NewBorderAll()},
+			Padding: NewSquareEdgeInsets(2),
+		},
+		ThemeTableCell: {
+			Borders: []Border{NewBorderAll()},
+			Padding: NewSquareEdgeInsets(2),
+		},
+	}
+}
+
+func (t TextTheme) Style(key string) TextStyle {
+	style := DefaultTextTheme()[key]
+	if custom, ok := t[key]; ok {
+		style = custom.Merge(style)
+	}
+	return style
+}